@@ -0,0 +1,105 @@
+// Command pacectl is a small companion CLI for driving a running PACE
+// Terminal instance over its local control socket (internal/ipc) - for shell
+// scripts, tmux hooks, or window-manager keybinds that want to control PACE
+// without attaching to its terminal.
+//
+// Usage:
+//
+//	pacectl refresh weather|news
+//	pacectl mode dashboard|minimal|toggle
+//	pacectl send <message>
+//	pacectl status
+//	pacectl quit
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/ipc"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	req := ipc.Request{
+		Command: os.Args[1],
+		Args:    os.Args[2:],
+	}
+
+	resp, err := send(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pacectl: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !resp.OK {
+		fmt.Fprintf(os.Stderr, "pacectl: %s\n", resp.Error)
+		os.Exit(1)
+	}
+
+	if resp.Status != nil {
+		out, err := json.MarshalIndent(resp.Status, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "pacectl: failed to format status: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(out))
+	}
+}
+
+// send opens a connection to the PACE control socket, writes req as one
+// JSON line, and reads back one JSON response line.
+func send(req ipc.Request) (*ipc.Response, error) {
+	path, err := ipc.SocketPath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve control socket: %w", err)
+	}
+
+	conn, err := ipc.Dial(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s (is PACE running?): %w", path, err)
+	}
+	defer conn.Close()
+
+	line, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	if _, err := conn.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil, fmt.Errorf("connection closed with no response")
+	}
+
+	var resp ipc.Response
+	if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &resp, nil
+}
+
+func printUsage() {
+	fmt.Fprint(os.Stderr, strings.TrimLeft(`
+Usage: pacectl <command> [args...]
+
+Commands:
+  refresh weather|news      Force-refresh a panel
+  mode dashboard|minimal|toggle   Switch the UI layout mode
+  send <message>             Send a chat message
+  status                     Print connection state and panel data as JSON
+  quit                       Shut down the running PACE instance
+`, "\n"))
+}
@@ -0,0 +1,400 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
+)
+
+// ConnectionState represents the current state of the connection
+type ConnectionState string
+
+const (
+	StateConnected     ConnectionState = "connected"
+	StateDisconnecting ConnectionState = "disconnecting"
+	StateReconnecting  ConnectionState = "reconnecting"
+	StateDisconnected  ConnectionState = "disconnected"
+)
+
+// Client manages the connection to PACE server. It speaks whichever
+// Transport from its preference list connects successfully, trying each in
+// order on both the initial Connect and every Reconnect - a proxy that
+// breaks the WebSocket upgrade just means Client transparently downgrades
+// to SSE.
+type Client struct {
+	host                 string
+	port                 int
+	preference           []TransportKind
+	reconnectDelay       time.Duration
+	maxReconnectDelay    time.Duration
+	maxReconnectAttempts int // 0 = retry forever
+
+	active Transport
+	state  ConnectionState
+	mu     sync.RWMutex
+
+	// nextReconnectAt is when Reconnect's current backoff sleep will end,
+	// surfaced through ReconnectStatus for the UI.
+	nextReconnectAt time.Time
+
+	// Channels for communication
+	messages  chan *protocol.Message
+	errors    chan error
+	connected chan bool
+
+	router *topicRouter
+
+	// pending holds a one-shot reply channel for each Envelope sent via
+	// Request that hasn't received its InReplyTo reply yet, keyed by
+	// request ID. Guarded by mu like the rest of Client's mutable state.
+	pending map[string]chan *protocol.Envelope
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     *shutdown.WaitGroup
+
+	// Reconnection tracking
+	reconnectAttempts int
+}
+
+// New creates a new Client. preference controls which transports Connect
+// tries and in what order; a nil or empty preference falls back to
+// DefaultTransportPreference. maxReconnectAttempts bounds how many times
+// Reconnect retries before giving up and settling into StateDisconnected;
+// 0 means retry forever.
+func New(host string, port int, reconnectDelay, maxReconnectDelay time.Duration, maxReconnectAttempts int, preference []TransportKind) *Client {
+	if len(preference) == 0 {
+		preference = DefaultTransportPreference
+	}
+
+	return &Client{
+		host:                 host,
+		port:                 port,
+		preference:           preference,
+		reconnectDelay:       reconnectDelay,
+		maxReconnectDelay:    maxReconnectDelay,
+		maxReconnectAttempts: maxReconnectAttempts,
+		state:                StateDisconnected,
+		messages:             make(chan *protocol.Message, 100),
+		errors:               make(chan error, 10),
+		connected:            make(chan bool, 10),
+		router:               newTopicRouter(),
+		ctx:                  context.Background(),
+	}
+}
+
+// legacyTopics maps the fixed auto-fetch query strings WeatherManager and
+// NewsManager poll with onto the topic a topic-aware server would tag them
+// with, so their Subscribe handlers fire even against a server that only
+// speaks the untagged "query$$response" wire format.
+var legacyTopics = map[string]string{
+	"What's the weather?": "weather.current",
+	"What's the news?":    "news.headlines",
+}
+
+// Start registers the Client with the app's shutdown coordination. The
+// active transport's read/write loops all register with wg and exit once
+// ctx is cancelled (by Close, or a parent shutdown) instead of relying on
+// channels that panic if closed twice.
+func (c *Client) Start(ctx context.Context, wg *shutdown.WaitGroup) {
+	ctx, cancel := context.WithCancel(ctx)
+	c.ctx = ctx
+	c.cancel = cancel
+	c.wg = wg
+}
+
+// Connect tries each transport in c.preference in order, adopting the
+// first one that connects successfully.
+func (c *Client) Connect() error {
+	var lastErr error
+
+	for _, kind := range c.preference {
+		t := newTransport(kind, c.host, c.port)
+		if t == nil {
+			continue
+		}
+
+		if err := t.Connect(c.ctx, c.wg, c.handleMessage, c.handleTransportError); err != nil {
+			lastErr = err
+			continue
+		}
+
+		c.mu.Lock()
+		c.active = t
+		c.state = StateConnected
+		c.reconnectAttempts = 0
+		c.mu.Unlock()
+
+		select {
+		case c.connected <- true:
+		default:
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("failed to connect via any transport: %w", lastErr)
+}
+
+// handleMessage is the onMessage callback every Transport delivers raw
+// messages to. A JSON object is a protocol.Envelope (used by Request and,
+// in the future, any server that replies in kind); anything else is the
+// original delimited protocol.Message format.
+func (c *Client) handleMessage(raw string) {
+	if looksLikeEnvelope(raw) {
+		if env, err := protocol.ParseEnvelope(raw); err == nil {
+			c.handleEnvelope(env)
+			return
+		}
+	}
+
+	msg, err := protocol.Parse(raw)
+	if err != nil {
+		select {
+		case c.errors <- fmt.Errorf("failed to parse message: %w", err):
+		default:
+		}
+		return
+	}
+
+	c.dispatchMessage(msg)
+}
+
+// looksLikeEnvelope reports whether raw could be a JSON-encoded Envelope,
+// cheaply enough to try on every message without parsing twice in the
+// common case.
+func looksLikeEnvelope(raw string) bool {
+	trimmed := strings.TrimSpace(raw)
+	return strings.HasPrefix(trimmed, "{")
+}
+
+// handleEnvelope routes env to whichever in-flight Request is waiting for
+// it, or - for an unsolicited envelope carrying a Topic - to the topic
+// router, same as a legacy Message tagged with Topic.
+func (c *Client) handleEnvelope(env *protocol.Envelope) {
+	if env.InReplyTo != "" {
+		c.mu.Lock()
+		reply, ok := c.pending[env.InReplyTo]
+		c.mu.Unlock()
+
+		if ok {
+			select {
+			case reply <- env:
+			default:
+			}
+			return
+		}
+	}
+
+	if env.Topic != "" {
+		c.router.publish(env.Topic, &protocol.Message{Topic: env.Topic, Response: string(env.Payload)})
+	}
+}
+
+// dispatchMessage handles a parsed delimited-format Message: topic dispatch
+// to any Subscribe handlers (falling back to the legacy query-string
+// mapping for a server that doesn't tag topics), then the general Messages()
+// channel.
+func (c *Client) dispatchMessage(msg *protocol.Message) {
+	topic := msg.Topic
+	if topic == "" {
+		topic = legacyTopics[msg.Query]
+	}
+	if topic != "" {
+		c.router.publish(topic, msg)
+	}
+
+	select {
+	case c.messages <- msg:
+	default:
+		// Channel full, log and continue
+		logging.Get("client").Warn("message channel full, dropping message")
+	}
+}
+
+// handleTransportError is the onError callback every Transport delivers
+// read-loop errors to.
+func (c *Client) handleTransportError(err error) {
+	select {
+	case c.errors <- err:
+	default:
+	}
+	c.handleDisconnect()
+}
+
+// Send sends a query to the server via the active transport
+func (c *Client) Send(query string) error {
+	c.mu.RLock()
+	state := c.state
+	active := c.active
+	c.mu.RUnlock()
+
+	if state != StateConnected || active == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	return active.Send(query)
+}
+
+// Messages returns the channel for receiving parsed messages
+func (c *Client) Messages() <-chan *protocol.Message {
+	return c.messages
+}
+
+// Errors returns the channel for receiving errors
+func (c *Client) Errors() <-chan error {
+	return c.errors
+}
+
+// Connected returns the channel for receiving connection events
+func (c *Client) Connected() <-chan bool {
+	return c.connected
+}
+
+// State returns the current connection state
+func (c *Client) State() ConnectionState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+// IsConnected returns true if the client is connected
+func (c *Client) IsConnected() bool {
+	return c.State() == StateConnected
+}
+
+// handleDisconnect handles disconnection and initiates reconnection
+func (c *Client) handleDisconnect() {
+	// Don't reconnect if this disconnect was caused by a deliberate shutdown.
+	if c.ctx.Err() != nil {
+		return
+	}
+
+	c.mu.Lock()
+	if c.active != nil {
+		c.active.Close()
+		c.active = nil
+	}
+	c.state = StateReconnecting
+	c.mu.Unlock()
+
+	// Attempt to reconnect
+	go c.Reconnect()
+}
+
+// Reconnect attempts to reconnect, trying each transport in c.preference in
+// order just like the initial Connect. Delays follow AWS's decorrelated
+// jitter recipe rather than plain exponential backoff, so a fleet of
+// clients that all lost their connection at once (a server restart) don't
+// retry in lockstep. It gives up after maxReconnectAttempts (0 = never).
+func (c *Client) Reconnect() {
+	if c.wg != nil {
+		c.wg.Add("client-reconnect")
+		defer c.wg.Done("client-reconnect")
+	}
+
+	prev := c.reconnectDelay
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		default:
+		}
+
+		c.mu.Lock()
+		c.reconnectAttempts++
+		attempt := c.reconnectAttempts
+		c.mu.Unlock()
+
+		if c.maxReconnectAttempts > 0 && attempt > c.maxReconnectAttempts {
+			c.errors <- fmt.Errorf("giving up after %d reconnect attempts", c.maxReconnectAttempts)
+			c.mu.Lock()
+			c.state = StateDisconnected
+			c.mu.Unlock()
+			return
+		}
+
+		sleep := c.reconnectDelay + randDuration(prev*3-c.reconnectDelay)
+		if sleep > c.maxReconnectDelay {
+			sleep = c.maxReconnectDelay
+		}
+		prev = sleep
+
+		c.mu.Lock()
+		c.nextReconnectAt = time.Now().Add(sleep)
+		c.mu.Unlock()
+
+		logging.Get("client").Info("reconnecting", "delay", sleep, "attempt", attempt)
+		time.Sleep(sleep)
+
+		err := c.Connect()
+		if err == nil {
+			logging.Get("client").Info("reconnected successfully")
+			return
+		}
+
+		c.errors <- fmt.Errorf("reconnection failed: %w", err)
+	}
+}
+
+// randDuration returns a random duration in [0, n). A non-positive n
+// (reconnectDelay itself already dominates prev*3) returns 0 rather than
+// panicking.
+func randDuration(n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(n)))
+}
+
+// ReconnectStatus describes where Client currently stands in its reconnect
+// loop, for UI display (e.g. "reconnecting in 4s (attempt 3/10)"). Only
+// meaningful while State() is StateReconnecting.
+type ReconnectStatus struct {
+	Attempt     int
+	MaxAttempts int // 0 means unlimited
+	NextAttempt time.Time
+}
+
+// ReconnectStatus returns the current reconnect progress.
+func (c *Client) ReconnectStatus() ReconnectStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return ReconnectStatus{
+		Attempt:     c.reconnectAttempts,
+		MaxAttempts: c.maxReconnectAttempts,
+		NextAttempt: c.nextReconnectAt,
+	}
+}
+
+// Close gracefully closes the connection. It's safe to call more than once.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.state = StateDisconnecting
+	c.mu.Unlock()
+
+	// Signal goroutines to stop
+	if c.cancel != nil {
+		c.cancel()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var err error
+	if c.active != nil {
+		err = c.active.Close()
+		c.active = nil
+	}
+
+	c.state = StateDisconnected
+	return err
+}
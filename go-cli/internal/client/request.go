@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
+)
+
+// Request sends payload to the server as a typed Envelope and blocks until
+// a reply Envelope with a matching InReplyTo arrives or ctx is done.
+// Managers that need to correlate a specific response to a specific
+// request - rather than just reacting to whatever arrives next on
+// Messages() or a Subscribe topic - use this instead of Send.
+func (c *Client) Request(ctx context.Context, msgType string, payload any) (*protocol.Envelope, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request payload: %w", err)
+	}
+
+	env := &protocol.Envelope{
+		ID:      newRequestID(),
+		Type:    msgType,
+		Payload: data,
+	}
+
+	reply := make(chan *protocol.Envelope, 1)
+
+	c.mu.Lock()
+	if c.pending == nil {
+		c.pending = make(map[string]chan *protocol.Envelope)
+	}
+	c.pending[env.ID] = reply
+	state := c.state
+	active := c.active
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, env.ID)
+		c.mu.Unlock()
+	}()
+
+	if state != StateConnected || active == nil {
+		return nil, fmt.Errorf("not connected to server")
+	}
+
+	if err := active.Send(env.String()); err != nil {
+		return nil, err
+	}
+
+	select {
+	case env := <-reply:
+		return env, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// newRequestID returns a random UUIDv4 string - unique enough to correlate
+// in-flight requests with their replies, which is all Request needs it for.
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("req-%d", time.Now().UnixNano())
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
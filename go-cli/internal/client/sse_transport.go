@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+)
+
+// sseTransport falls back to a long-lived Server-Sent Events GET for
+// inbound messages and plain POSTs for outbound ones, for environments
+// where a proxy breaks the WebSocket upgrade but allows a long-lived HTTP
+// response through.
+type sseTransport struct {
+	host string
+	port int
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// Connect implements Transport.
+func (t *sseTransport) Connect(ctx context.Context, wg *shutdown.WaitGroup, onMessage func(string), onError func(error)) error {
+	url := fmt.Sprintf("http://%s:%d/events", t.host, t.port)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request for %s: %w", url, err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", url, err)
+	}
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		resp.Body.Close()
+		return fmt.Errorf("%s did not return an event stream (status %s)", url, resp.Status)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.mu.Lock()
+	t.cancel = cancel
+	t.mu.Unlock()
+
+	wg.Add("client-sse-read")
+	go t.readLoop(ctx, wg, resp, onMessage, onError)
+
+	return nil
+}
+
+// readLoop scans resp.Body for SSE "data: ..." lines, delivering each one's
+// content to onMessage verbatim. It exits quietly once ctx is cancelled by
+// Close.
+func (t *sseTransport) readLoop(ctx context.Context, wg *shutdown.WaitGroup, resp *http.Response, onMessage func(string), onError func(error)) {
+	defer wg.Done("client-sse-read")
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+
+		onMessage(strings.TrimSpace(data))
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		onError(fmt.Errorf("sse error: %w", err))
+	}
+}
+
+// Send implements Transport.
+func (t *sseTransport) Send(query string) error {
+	url := fmt.Sprintf("http://%s:%d/send", t.host, t.port)
+
+	resp, err := http.Post(url, "text/plain", strings.NewReader(query))
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("send to %s failed: %s", url, resp.Status)
+	}
+
+	return nil
+}
+
+// Close implements Transport.
+func (t *sseTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+	}
+
+	return nil
+}
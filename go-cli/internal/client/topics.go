@@ -0,0 +1,130 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
+)
+
+// Topic tokens are dot-separated ("news.headlines", "weather.current").
+// Within a subscription pattern, "*" matches exactly one token and ">"
+// matches one or more trailing tokens - the same wildcard syntax NATS
+// subjects use. ">" is only meaningful as the final token of a pattern.
+const (
+	wildcardToken = "*"
+	multiToken    = ">"
+)
+
+// topicNode is one segment of the subscription trie (also called a
+// qlobber): each node is keyed by literal topic token, with dedicated
+// children for the "*" and ">" wildcards. Publishing a topic walks every
+// branch its tokens could match and gathers handlers along the way.
+type topicNode struct {
+	children map[string]*topicNode
+	wildcard *topicNode
+	multi    *topicNode
+	handlers []func(*protocol.Message)
+}
+
+func newTopicNode() *topicNode {
+	return &topicNode{children: make(map[string]*topicNode)}
+}
+
+// topicRouter is the trie backing Client.Subscribe/Publish, guarding
+// concurrent access from manager goroutines and the read pump.
+type topicRouter struct {
+	mu   sync.RWMutex
+	root *topicNode
+}
+
+func newTopicRouter() *topicRouter {
+	return &topicRouter{root: newTopicNode()}
+}
+
+// subscribe registers handler under pattern.
+func (r *topicRouter) subscribe(pattern string, handler func(*protocol.Message)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	node := r.root
+	for _, token := range strings.Split(pattern, ".") {
+		switch token {
+		case wildcardToken:
+			if node.wildcard == nil {
+				node.wildcard = newTopicNode()
+			}
+			node = node.wildcard
+		case multiToken:
+			if node.multi == nil {
+				node.multi = newTopicNode()
+			}
+			node = node.multi
+		default:
+			child, ok := node.children[token]
+			if !ok {
+				child = newTopicNode()
+				node.children[token] = child
+			}
+			node = child
+		}
+	}
+	node.handlers = append(node.handlers, handler)
+}
+
+// publish runs every handler subscribed to a pattern matching topic.
+func (r *topicRouter) publish(topic string, msg *protocol.Message) {
+	r.mu.RLock()
+	var matched []func(*protocol.Message)
+	collectHandlers(r.root, strings.Split(topic, "."), &matched)
+	r.mu.RUnlock()
+
+	for _, handler := range matched {
+		handler(msg)
+	}
+}
+
+// collectHandlers walks node for the remaining topic tokens, appending
+// every handler reachable via a literal, "*", or ">" branch.
+func collectHandlers(node *topicNode, tokens []string, out *[]func(*protocol.Message)) {
+	if node == nil {
+		return
+	}
+
+	if node.multi != nil && len(tokens) > 0 {
+		*out = append(*out, node.multi.handlers...)
+	}
+
+	if len(tokens) == 0 {
+		*out = append(*out, node.handlers...)
+		return
+	}
+
+	collectHandlers(node.children[tokens[0]], tokens[1:], out)
+	collectHandlers(node.wildcard, tokens[1:], out)
+}
+
+// Subscribe registers handler to run on every message published to topic,
+// directly or via Publish, or delivered by the server on a matching topic
+// (see protocol.Message.Topic). Managers call this once at startup instead
+// of pattern-sniffing query text off the shared Messages() channel.
+func (c *Client) Subscribe(topic string, handler func(*protocol.Message)) {
+	c.router.subscribe(topic, handler)
+}
+
+// Publish runs every handler subscribed to a topic matching topic with a
+// synthesized Message carrying payload's string form as its Response.
+func (c *Client) Publish(topic string, payload any) {
+	c.router.publish(topic, &protocol.Message{Topic: topic, Response: toResponseString(payload)})
+}
+
+func toResponseString(payload any) string {
+	if s, ok := payload.(string); ok {
+		return s
+	}
+	if s, ok := payload.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("%v", payload)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+)
+
+// TransportKind identifies one of the wire protocols Client knows how to
+// speak to the PACE server.
+type TransportKind string
+
+const (
+	// WebSocket is the original bidirectional transport.
+	WebSocket TransportKind = "websocket"
+	// SSE falls back to a long-lived text/event-stream GET for inbound
+	// messages and plain POSTs for outbound ones, for proxies that break
+	// WebSocket upgrades but allow long-lived HTTP responses.
+	SSE TransportKind = "sse"
+)
+
+// DefaultTransportPreference is the order Client tries transports in when
+// the caller doesn't specify one: WebSocket first, falling back to SSE.
+var DefaultTransportPreference = []TransportKind{WebSocket, SSE}
+
+// Transport is the wire-level connection to the PACE server. Client drives
+// reconnection and message dispatch identically regardless of which
+// Transport is active, so a transport only needs to know how to dial,
+// send, and close.
+type Transport interface {
+	// Connect establishes the connection and, on success, starts its read
+	// loop in its own goroutine registered with wg. Every raw message the
+	// read loop receives is delivered to onMessage verbatim - Client decides
+	// whether it's a delimited Message or a JSON Envelope, so a transport
+	// only needs to move bytes. A read-loop error other than a clean
+	// shutdown is delivered to onError. Connect itself returns once the
+	// connection is established (or definitively fails) - it does not block
+	// for the lifetime of the connection.
+	Connect(ctx context.Context, wg *shutdown.WaitGroup, onMessage func(raw string), onError func(error)) error
+
+	// Send transmits a raw query string to the server.
+	Send(query string) error
+
+	// Close closes the underlying connection. Safe to call more than once.
+	Close() error
+}
+
+// newTransport builds an unconnected Transport for kind, targeting
+// host:port. It returns nil for an unrecognized kind so callers can skip it
+// when walking a preference list.
+func newTransport(kind TransportKind, host string, port int) Transport {
+	switch kind {
+	case WebSocket:
+		return &websocketTransport{host: host, port: port}
+	case SSE:
+		return &sseTransport{host: host, port: port}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/gorilla/websocket"
+)
+
+// websocketTransport is the original bidirectional Transport.
+type websocketTransport struct {
+	host string
+	port int
+
+	mu     sync.RWMutex
+	conn   *websocket.Conn
+	cancel context.CancelFunc
+
+	sendQueue chan string
+}
+
+// Connect implements Transport.
+func (t *websocketTransport) Connect(ctx context.Context, wg *shutdown.WaitGroup, onMessage func(string), onError func(error)) error {
+	url := fmt.Sprintf("ws://%s:%d", t.host, t.port)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", url, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	t.mu.Lock()
+	t.conn = conn
+	t.cancel = cancel
+	t.sendQueue = make(chan string, 100)
+	t.mu.Unlock()
+
+	wg.Add("client-read-pump")
+	wg.Add("client-write-pump")
+	go t.readPump(wg, onMessage, onError)
+	go t.writePump(ctx, wg, onError)
+
+	return nil
+}
+
+// readPump reads messages from the WebSocket connection
+func (t *websocketTransport) readPump(wg *shutdown.WaitGroup, onMessage func(string), onError func(error)) {
+	defer wg.Done("client-read-pump")
+
+	t.mu.RLock()
+	conn := t.conn
+	t.mu.RUnlock()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				onError(fmt.Errorf("websocket error: %w", err))
+			}
+			return
+		}
+
+		onMessage(string(message))
+	}
+}
+
+// writePump writes queued messages to the WebSocket connection
+func (t *websocketTransport) writePump(ctx context.Context, wg *shutdown.WaitGroup, onError func(error)) {
+	defer wg.Done("client-write-pump")
+
+	t.mu.RLock()
+	conn := t.conn
+	t.mu.RUnlock()
+
+	for {
+		select {
+		case message := <-t.sendQueue:
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+				onError(fmt.Errorf("failed to send message: %w", err))
+				return
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Send implements Transport.
+func (t *websocketTransport) Send(query string) error {
+	t.mu.RLock()
+	queue := t.sendQueue
+	t.mu.RUnlock()
+
+	if queue == nil {
+		return fmt.Errorf("not connected to server")
+	}
+
+	select {
+	case queue <- query:
+		return nil
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("timeout sending message")
+	}
+}
+
+// Close implements Transport.
+func (t *websocketTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+	}
+
+	if t.conn != nil {
+		err := t.conn.WriteMessage(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		)
+		t.conn.Close()
+		t.conn = nil
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -15,8 +15,9 @@ type Config struct {
 	Port int
 
 	// Reconnection settings
-	ReconnectDelay    time.Duration
-	MaxReconnectDelay time.Duration
+	ReconnectDelay       time.Duration
+	MaxReconnectDelay    time.Duration
+	MaxReconnectAttempts int // 0 = retry forever
 
 	// Manager refresh intervals
 	WeatherRefreshInterval time.Duration
@@ -29,6 +30,23 @@ type Config struct {
 	// UI settings
 	UIMode         string // "dashboard" or "minimal"
 	RememberUIMode bool   // Persist mode across sessions
+	Theme          string // "dark256", "light", "solarized", or a custom theme name
+	LayoutProfile  string // "dashboard", "minimal", "focus-chat", "focus-news", or a custom profile name
+	Keymap         string // "emacs" (default) or "vi"
+
+	// Feature settings
+	WeatherFormat    string        // "text" or "json"; selects the WeatherProvider
+	AutoFetchWeather bool          // whether WeatherManager auto-refreshes in the background
+	AutoFetchNews    bool          // whether NewsManager auto-refreshes in the background
+	CacheMaxAge      time.Duration // suppresses a redundant startup Fetch() while the weather/news disk cache is younger than this
+
+	// Logging settings
+	LogLevel      string // "debug", "info", "warn", or "error"
+	LogSink       string // "console" or "file"
+	LogFile       string
+	LogMaxSizeMB  int
+	LogMaxAgeDays int
+	LogMaxBackups int
 
 	// File config reference
 	fileConfig *FileConfig
@@ -36,6 +54,18 @@ type Config struct {
 
 	// Flag tracking
 	hasModeFlagOverride bool
+
+	// changes carries deltas pushed by a Watcher when config.yaml is edited
+	// while the app is running. Unbuffered-but-for-slack: sized so a burst
+	// of edited fields from one reload doesn't block the watcher goroutine.
+	changes chan ConfigDelta
+}
+
+// Changes returns the channel a Watcher pushes hot-reloaded config deltas
+// onto. Only meaningful once a Watcher has been started with this Config;
+// otherwise it simply never fires.
+func (c *Config) Changes() <-chan ConfigDelta {
+	return c.changes
 }
 
 // Load creates a Config from command-line flags and environment variables
@@ -51,13 +81,29 @@ func Load() (*Config, error) {
 		TimeRefreshInterval:    1 * time.Second,
 		MessageTimeout:         30 * time.Second,
 		RememberUIMode:         true,
+		Theme:                  "dark256",
+		LayoutProfile:          "dashboard",
+		Keymap:                 "emacs",
+		WeatherFormat:          "text",
+		AutoFetchWeather:       true,
+		AutoFetchNews:          true,
+		CacheMaxAge:            5 * time.Minute,
+		LogLevel:               "info",
+		LogSink:                "file",
+		LogMaxSizeMB:           10,
+		LogMaxAgeDays:          7,
+		LogMaxBackups:          3,
+		changes:                make(chan ConfigDelta, 8),
 	}
 
 	// Command-line flags
 	host := flag.String("host", "", "WebSocket server host")
 	port := flag.Int("port", 0, "WebSocket server port")
 	mode := flag.String("mode", "", "UI mode: dashboard or minimal")
+	theme := flag.String("theme", "", "Color theme: dark256, light, solarized, or a custom theme name")
+	keymap := flag.String("keymap", "", "Input editing mode: emacs or vi")
 	interactive := flag.Bool("interactive", false, "Force layout selection menu")
+	logLevel := flag.String("log-level", "", "Log level: debug, info, warn, or error")
 	help := flag.Bool("help", false, "Show help message")
 	flag.BoolVar(help, "h", false, "Show help message (shorthand)")
 
@@ -86,6 +132,47 @@ func Load() (*Config, error) {
 	}
 	cfg.UIMode = fileConfig.UI.Mode
 	cfg.RememberUIMode = fileConfig.UI.RememberMode
+	if fileConfig.UI.Theme != "" {
+		cfg.Theme = fileConfig.UI.Theme
+	}
+	if fileConfig.UI.LayoutProfile != "" {
+		cfg.LayoutProfile = fileConfig.UI.LayoutProfile
+	}
+	if fileConfig.UI.Keymap != "" {
+		cfg.Keymap = fileConfig.UI.Keymap
+	}
+	if fileConfig.Features.WeatherFormat != "" {
+		cfg.WeatherFormat = fileConfig.Features.WeatherFormat
+	}
+	if fileConfig.Features.WeatherRefreshInterval != 0 {
+		cfg.WeatherRefreshInterval = time.Duration(fileConfig.Features.WeatherRefreshInterval) * time.Second
+	}
+	if fileConfig.Features.NewsRefreshInterval != 0 {
+		cfg.NewsRefreshInterval = time.Duration(fileConfig.Features.NewsRefreshInterval) * time.Second
+	}
+	cfg.AutoFetchWeather = fileConfig.Features.AutoFetchWeather
+	cfg.AutoFetchNews = fileConfig.Features.AutoFetchNews
+	if fileConfig.Features.CacheMaxAge != 0 {
+		cfg.CacheMaxAge = time.Duration(fileConfig.Features.CacheMaxAge) * time.Second
+	}
+	if fileConfig.Logging.Level != "" {
+		cfg.LogLevel = fileConfig.Logging.Level
+	}
+	if fileConfig.Logging.Sink != "" {
+		cfg.LogSink = fileConfig.Logging.Sink
+	}
+	if fileConfig.Logging.File != "" {
+		cfg.LogFile = fileConfig.Logging.File
+	}
+	if fileConfig.Logging.MaxSizeMB != 0 {
+		cfg.LogMaxSizeMB = fileConfig.Logging.MaxSizeMB
+	}
+	if fileConfig.Logging.MaxAgeDays != 0 {
+		cfg.LogMaxAgeDays = fileConfig.Logging.MaxAgeDays
+	}
+	if fileConfig.Logging.MaxBackups != 0 {
+		cfg.LogMaxBackups = fileConfig.Logging.MaxBackups
+	}
 
 	// Environment variables override file config
 	if envHost := os.Getenv("PACE_HOST"); envHost != "" {
@@ -102,6 +189,18 @@ func Load() (*Config, error) {
 		cfg.UIMode = envMode
 	}
 
+	if envTheme := os.Getenv("PACE_THEME"); envTheme != "" {
+		cfg.Theme = envTheme
+	}
+
+	if envKeymap := os.Getenv("PACE_KEYMAP"); envKeymap != "" {
+		cfg.Keymap = envKeymap
+	}
+
+	if envLogLevel := os.Getenv("PACE_LOG_LEVEL"); envLogLevel != "" {
+		cfg.LogLevel = envLogLevel
+	}
+
 	// Command-line flags override environment variables
 	if *host != "" {
 		cfg.Host = *host
@@ -116,6 +215,18 @@ func Load() (*Config, error) {
 		cfg.hasModeFlagOverride = true
 	}
 
+	if *theme != "" {
+		cfg.Theme = *theme
+	}
+
+	if *keymap != "" {
+		cfg.Keymap = *keymap
+	}
+
+	if *logLevel != "" {
+		cfg.LogLevel = *logLevel
+	}
+
 	// Interactive flag forces menu
 	if *interactive {
 		cfg.UIMode = "" // Clear mode to trigger menu
@@ -140,6 +251,49 @@ func (c *Config) SaveUIMode(mode string) error {
 	return SaveToFile(c.fileConfig)
 }
 
+// SaveLayoutProfile persists the active dashboard layout profile to the
+// config file, the same file used by SaveUIMode.
+func (c *Config) SaveLayoutProfile(name string) error {
+	if !c.RememberUIMode {
+		return nil // Don't save if user disabled persistence
+	}
+
+	if c.fileConfig == nil {
+		c.fileConfig = defaultFileConfig()
+	}
+
+	c.fileConfig.UI.LayoutProfile = name
+	c.LayoutProfile = name
+
+	return SaveToFile(c.fileConfig)
+}
+
+// SaveTabs persists the open tabs and the active tab index to the config
+// file, the same file used by SaveUIMode.
+func (c *Config) SaveTabs(tabs []TabConfig, active int) error {
+	if !c.RememberUIMode {
+		return nil // Don't save if user disabled persistence
+	}
+
+	if c.fileConfig == nil {
+		c.fileConfig = defaultFileConfig()
+	}
+
+	c.fileConfig.UI.Tabs = tabs
+	c.fileConfig.UI.ActiveTab = active
+
+	return SaveToFile(c.fileConfig)
+}
+
+// SavedTabs returns the tabs and active index loaded from the config file,
+// if any were persisted.
+func (c *Config) SavedTabs() ([]TabConfig, int) {
+	if c.fileConfig == nil {
+		return nil, 0
+	}
+	return c.fileConfig.UI.Tabs, c.fileConfig.UI.ActiveTab
+}
+
 // HasModeFlagOverride returns true if --mode flag was provided
 func (c *Config) HasModeFlagOverride() bool {
 	return c.hasModeFlagOverride
@@ -153,18 +307,27 @@ Usage: pace-cli [options]
 Options:
   --host <host>      WebSocket server host (default: localhost)
   --port <port>      WebSocket server port (default: 9001)
-  --mode <mode>      UI mode: dashboard or minimal
+  --mode <mode>      UI mode: dashboard, minimal, or adaptive[:N%]
+  --theme <theme>    Color theme: dark256, light, solarized, or a custom
+                     theme name from $XDG_CONFIG_HOME/pace/themes/
+  --keymap <mode>    Input editing mode: emacs (default) or vi
   --interactive      Force layout selection menu
+  --log-level <lvl>  Log level: debug, info, warn, or error (default: info)
   --help, -h         Show this help message
 
 Environment Variables:
   PACE_HOST          WebSocket server host
   PACE_PORT          WebSocket server port
   PACE_UI_MODE       UI mode: dashboard or minimal
+  PACE_THEME         Color theme
+  PACE_KEYMAP        Input editing mode: emacs or vi
+  PACE_LOG_LEVEL     Log level: debug, info, warn, or error
 
 Layout Modes:
   Dashboard          Multi-panel layout with weather, news, and chat
   Minimal            Full-width chat-focused interface
+  Adaptive:N%        Sizes itself to the current content, up to N% of the
+                     terminal height, fzf --height style (default 40%)
 
   Toggle modes with Ctrl+D (dashboard) or Ctrl+M (minimal)
 
@@ -175,13 +338,26 @@ Commands (in terminal):
   /dashboard         Switch to dashboard mode
   /minimal, /min     Switch to minimal mode
   /mode toggle       Toggle between modes
+  /tab new/close/next/prev  Manage tabs
+  /spinner braille/ascii/dots  Change the loading animation
+  /focus dashboard/minimal/focus-chat/focus-news  Switch panel layout profile
+  /keymap emacs/vi   Switch input editing mode
   /help              Show help in terminal
 
 Keyboard Shortcuts:
   Ctrl+D             Switch to dashboard mode
   Ctrl+M             Switch to minimal mode
   Ctrl+T             Toggle between modes
-  →                  Next news headline (dashboard mode)
-  ←                  Previous news headline (dashboard mode)
+  Ctrl+N             New tab
+  Ctrl+W             Close tab
+  Ctrl+Tab           Next tab (Ctrl+Shift+Tab for previous)
+  Alt+1..9           Jump to tab
+  Alt+→              Next news headline (dashboard mode)
+  Alt+←              Previous news headline (dashboard mode)
+  Alt+↑/Alt+↓        Grow/shrink the conversation panel (dashboard mode)
+  Alt+H/Alt+V        Flip panel orientation (dashboard mode)
+  ↑/↓                Walk through input history
+  Ctrl+R             Search input history
+  Tab                Complete a slash command
 `)
 }
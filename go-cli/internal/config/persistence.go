@@ -1,10 +1,12 @@
 package config
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 )
@@ -21,14 +23,41 @@ type FileConfig struct {
 	UI struct {
 		Mode         string `yaml:"mode"`          // "dashboard" or "minimal"
 		RememberMode bool   `yaml:"remember_mode"` // Persist mode across sessions
+		Theme        string `yaml:"theme"`         // "dark256", "light", "solarized", or a custom theme name
+
+		Tabs      []TabConfig `yaml:"tabs"`       // Open tabs, restored in order on the next launch
+		ActiveTab int         `yaml:"active_tab"` // Index into Tabs
+
+		LayoutProfile string `yaml:"layout_profile"` // "dashboard", "minimal", "focus-chat", "focus-news", or a custom profile name
+		Keymap        string `yaml:"keymap"`         // "emacs" (default) or "vi"
 	} `yaml:"ui"`
 
 	Features struct {
-		AutoFetchWeather       bool `yaml:"auto_fetch_weather"`
-		AutoFetchNews          bool `yaml:"auto_fetch_news"`
-		NewsRefreshInterval    int  `yaml:"news_refresh_interval"`    // seconds
-		WeatherRefreshInterval int  `yaml:"weather_refresh_interval"` // seconds
+		AutoFetchWeather       bool   `yaml:"auto_fetch_weather"`
+		AutoFetchNews          bool   `yaml:"auto_fetch_news"`
+		NewsRefreshInterval    int    `yaml:"news_refresh_interval"`    // seconds
+		WeatherRefreshInterval int    `yaml:"weather_refresh_interval"` // seconds
+		WeatherFormat          string `yaml:"weather_format"`           // "text" or "json"; selects the WeatherProvider
+		CacheMaxAge            int    `yaml:"cache_max_age"`            // seconds; suppresses a redundant startup Fetch() while the disk cache is younger than this
 	} `yaml:"features"`
+
+	Logging struct {
+		Level      string `yaml:"level"`        // "debug", "info", "warn", or "error"
+		Sink       string `yaml:"sink"`          // "console" or "file"
+		File       string `yaml:"file"`          // path for the "file" sink; defaults to $XDG_CONFIG_HOME/pace/pace.log
+		MaxSizeMB  int    `yaml:"max_size_mb"`   // rotate once the file exceeds this size
+		MaxAgeDays int    `yaml:"max_age_days"`  // delete rotated backups older than this
+		MaxBackups int    `yaml:"max_backups"`   // keep at most this many rotated backups
+	} `yaml:"logging"`
+}
+
+// TabConfig is the persisted identity of one tab: its title and pinned
+// locales. Conversation history itself is not persisted - it's rebuilt by
+// chatting once the tab is restored.
+type TabConfig struct {
+	Title         string `yaml:"title"`
+	WeatherLocale string `yaml:"weather_locale,omitempty"`
+	NewsLocale    string `yaml:"news_locale,omitempty"`
 }
 
 // getConfigPath returns the platform-specific config file path
@@ -65,6 +94,22 @@ func getConfigPath() (string, error) {
 	return filepath.Join(configDir, "config.yaml"), nil
 }
 
+// lastWrittenMu guards lastWrittenHash.
+var lastWrittenMu sync.Mutex
+
+// lastWrittenHash is the hash of the bytes SaveToFile most recently wrote,
+// so Watcher can tell its own save apart from an edit made outside the app
+// and skip reloading something it already has in memory.
+var lastWrittenHash [32]byte
+
+// wasLastWritten reports whether hash matches the content SaveToFile most
+// recently wrote.
+func wasLastWritten(hash [32]byte) bool {
+	lastWrittenMu.Lock()
+	defer lastWrittenMu.Unlock()
+	return hash == lastWrittenHash
+}
+
 // LoadFromFile loads configuration from the YAML file
 func LoadFromFile() (*FileConfig, error) {
 	configPath, err := getConfigPath()
@@ -106,6 +151,11 @@ func SaveToFile(cfg *FileConfig) error {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
+	hash := sha256.Sum256(data)
+	lastWrittenMu.Lock()
+	lastWrittenHash = hash
+	lastWrittenMu.Unlock()
+
 	return nil
 }
 
@@ -120,11 +170,22 @@ func defaultFileConfig() *FileConfig {
 
 	cfg.UI.Mode = "" // Empty means not set yet - will trigger selection menu
 	cfg.UI.RememberMode = true
+	cfg.UI.Theme = "dark256"
+	cfg.UI.LayoutProfile = "dashboard"
+	cfg.UI.Keymap = "emacs"
 
 	cfg.Features.AutoFetchWeather = true
 	cfg.Features.AutoFetchNews = true
 	cfg.Features.NewsRefreshInterval = 3600    // 1 hour
 	cfg.Features.WeatherRefreshInterval = 900  // 15 minutes
+	cfg.Features.WeatherFormat = "text"
+	cfg.Features.CacheMaxAge = 300 // 5 minutes
+
+	cfg.Logging.Level = "info"
+	cfg.Logging.Sink = "file"
+	cfg.Logging.MaxSizeMB = 10
+	cfg.Logging.MaxAgeDays = 7
+	cfg.Logging.MaxBackups = 3
 
 	return cfg
 }
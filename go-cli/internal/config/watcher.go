@@ -0,0 +1,218 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+)
+
+// debounceDelay absorbs the burst of fsnotify events a single editor save
+// can fire (write + chmod, or a temp-file write followed by a rename).
+const debounceDelay = 500 * time.Millisecond
+
+// ConfigDelta describes which live-reloadable settings changed in the most
+// recent reparse of config.yaml. A nil field means that setting didn't
+// change; callers only need to act on the non-nil ones.
+type ConfigDelta struct {
+	WeatherRefreshInterval *time.Duration
+	NewsRefreshInterval    *time.Duration
+	AutoFetchWeather       *bool
+	AutoFetchNews          *bool
+	UIMode                 *string
+}
+
+// Watcher watches the resolved config file for edits, debounces bursts of
+// fsnotify events, and diffs each reparse against its own record of the
+// last-applied values, pushing a ConfigDelta onto Config.Changes() for
+// whatever changed. It never touches the running Config directly - that
+// keeps applying a delta (pausing a manager, saving the UI mode back to
+// disk) on whichever goroutine already owns the Config.
+type Watcher struct {
+	changes chan<- ConfigDelta
+
+	path      string
+	fsWatcher *fsnotify.Watcher
+	lastHash  [32]byte
+
+	weatherRefreshInterval time.Duration
+	newsRefreshInterval    time.Duration
+	autoFetchWeather       bool
+	autoFetchNews          bool
+	uiMode                 string
+}
+
+// NewWatcher creates a Watcher seeded with cfg's current values. Start must
+// be called before it actually watches anything.
+func NewWatcher(cfg *Config) *Watcher {
+	return &Watcher{
+		changes:                cfg.changes,
+		weatherRefreshInterval: cfg.WeatherRefreshInterval,
+		newsRefreshInterval:    cfg.NewsRefreshInterval,
+		autoFetchWeather:       cfg.AutoFetchWeather,
+		autoFetchNews:          cfg.AutoFetchNews,
+		uiMode:                 cfg.UIMode,
+	}
+}
+
+// Start opens the fsnotify watch and begins reloading on changes. It
+// watches the config directory rather than the file itself, since editors
+// commonly save by writing a temp file and renaming it over the original,
+// which fsnotify can't track across. It registers with wg like the app's
+// other long-running loops.
+func (w *Watcher) Start(ctx context.Context, wg *shutdown.WaitGroup) error {
+	path, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	if err := fsw.Add(filepath.Dir(path)); err != nil {
+		fsw.Close()
+		return err
+	}
+
+	w.path = path
+	w.fsWatcher = fsw
+
+	wg.Add("config-watcher")
+	go func() {
+		defer wg.Done("config-watcher")
+		defer fsw.Close()
+
+		var debounce *time.Timer
+		var fire <-chan time.Time
+
+		for {
+			select {
+			case event, ok := <-fsw.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != w.path {
+					continue
+				}
+				if debounce == nil {
+					debounce = time.NewTimer(debounceDelay)
+				} else {
+					if !debounce.Stop() {
+						<-debounce.C
+					}
+					debounce.Reset(debounceDelay)
+				}
+				fire = debounce.C
+
+			case <-fire:
+				fire = nil
+				w.reload()
+
+			case err, ok := <-fsw.Errors:
+				if !ok {
+					return
+				}
+				logging.Get("config").Warn("watch error", "error", err)
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// reload reparses the config file and, if its content actually changed,
+// diffs it against the last-applied values and pushes a delta.
+func (w *Watcher) reload() {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		// Likely caught mid-rewrite (temp file not yet renamed); the
+		// rename will fire its own event.
+		return
+	}
+
+	hash := sha256.Sum256(data)
+	if hash == w.lastHash {
+		return // mtime-only touch, content unchanged
+	}
+	if wasLastWritten(hash) {
+		// Our own SaveToFile call - already reflected in the running
+		// Config, so there's nothing to diff.
+		w.lastHash = hash
+		return
+	}
+	w.lastHash = hash
+
+	var fc FileConfig
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		logging.Get("config").Warn("failed to parse reloaded config", "error", err)
+		return
+	}
+
+	if delta, ok := w.diff(&fc); ok {
+		select {
+		case w.changes <- delta:
+		default:
+			logging.Get("config").Warn("dropped config delta, Changes() channel full")
+		}
+	}
+}
+
+// diff compares fc against the Watcher's record of the last-applied
+// values, updating that record and building a ConfigDelta for whatever
+// changed.
+func (w *Watcher) diff(fc *FileConfig) (ConfigDelta, bool) {
+	var delta ConfigDelta
+	changed := false
+
+	if fc.Features.WeatherRefreshInterval != 0 {
+		interval := time.Duration(fc.Features.WeatherRefreshInterval) * time.Second
+		if interval != w.weatherRefreshInterval {
+			w.weatherRefreshInterval = interval
+			delta.WeatherRefreshInterval = &interval
+			changed = true
+		}
+	}
+
+	if fc.Features.NewsRefreshInterval != 0 {
+		interval := time.Duration(fc.Features.NewsRefreshInterval) * time.Second
+		if interval != w.newsRefreshInterval {
+			w.newsRefreshInterval = interval
+			delta.NewsRefreshInterval = &interval
+			changed = true
+		}
+	}
+
+	if fc.Features.AutoFetchWeather != w.autoFetchWeather {
+		v := fc.Features.AutoFetchWeather
+		w.autoFetchWeather = v
+		delta.AutoFetchWeather = &v
+		changed = true
+	}
+
+	if fc.Features.AutoFetchNews != w.autoFetchNews {
+		v := fc.Features.AutoFetchNews
+		w.autoFetchNews = v
+		delta.AutoFetchNews = &v
+		changed = true
+	}
+
+	if fc.UI.Mode != "" && fc.UI.Mode != w.uiMode {
+		v := fc.UI.Mode
+		w.uiMode = v
+		delta.UIMode = &v
+		changed = true
+	}
+
+	return delta, changed
+}
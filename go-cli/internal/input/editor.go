@@ -0,0 +1,337 @@
+package input
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// historyFileName is where submitted lines persist across sessions, read
+// and appended to by the readline.Instance itself.
+const historyFileName = ".jack_history"
+
+// newLineEditor builds the readline.Instance Start wires up to stdin/stdout:
+// up/down arrow history, Ctrl-R reverse search, and tab completion over the
+// slash commands all come from readline itself. Its Stdin is wrapped in an
+// interceptingReader so the app's own control keys (Ctrl+D/N/T/W, Alt+digit
+// tab jumps, Alt+Left/Right headline nav, SGR mouse reports) are stripped
+// out and dispatched before the line editor ever sees them.
+func newLineEditor(h *Handler) (*readline.Instance, error) {
+	return readline.NewEx(&readline.Config{
+		Prompt:          "> ",
+		HistoryFile:     historyFilePath(),
+		AutoComplete:    newCompleter(),
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		VimMode:         h.keymap == "vi",
+		Stdin:           newInterceptingReader(os.Stdin, h),
+	})
+}
+
+// historyFilePath returns ~/.jack_history, or just the bare filename (in the
+// working directory) if the home directory can't be resolved.
+func historyFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return historyFileName
+	}
+	return filepath.Join(home, historyFileName)
+}
+
+// newCompleter builds the tab-completion tree for the slash commands
+// processInput understands.
+func newCompleter() readline.AutoCompleter {
+	return readline.NewPrefixCompleter(
+		readline.PcItem("/quit"),
+		readline.PcItem("/exit"),
+		readline.PcItem("/clear"),
+		readline.PcItem("/refresh"),
+		readline.PcItem("/help"),
+		readline.PcItem("/dashboard"),
+		readline.PcItem("/dash"),
+		readline.PcItem("/minimal"),
+		readline.PcItem("/min"),
+		readline.PcItem("/toggle"),
+		readline.PcItem("/mode",
+			readline.PcItem("toggle"),
+		),
+		readline.PcItem("/tab",
+			readline.PcItem("new"),
+			readline.PcItem("close"),
+			readline.PcItem("next"),
+			readline.PcItem("prev"),
+		),
+		readline.PcItem("/spinner",
+			readline.PcItem("braille"),
+			readline.PcItem("ascii"),
+			readline.PcItem("dots"),
+		),
+		readline.PcItem("/focus",
+			readline.PcItem("dashboard"),
+			readline.PcItem("minimal"),
+			readline.PcItem("focus-chat"),
+			readline.PcItem("focus-news"),
+		),
+		readline.PcItem("/keymap",
+			readline.PcItem("emacs"),
+			readline.PcItem("vi"),
+		),
+	)
+}
+
+// interceptingReader sits between the raw terminal and the line editor. It
+// reads from src one byte at a time, the same way the original hand-rolled
+// reader did, but instead of interpreting every key itself, it only
+// intercepts the app-level sequences (Ctrl+D/N/T/W, Alt+digit, Alt+Left/
+// Right, Ctrl-Tab, SGR mouse reports) and dispatches them to h's channels.
+// Everything else - printable characters, Enter, Backspace, plain arrows,
+// Ctrl+R, Tab, Home/End - is forwarded untouched via pending so readline
+// can interpret it as a line-editing key exactly as if it had read stdin
+// directly.
+type interceptingReader struct {
+	src     io.Reader
+	h       *Handler
+	pending []byte
+}
+
+func newInterceptingReader(src io.Reader, h *Handler) *interceptingReader {
+	return &interceptingReader{src: src, h: h}
+}
+
+// Close is a no-op: the underlying stdin isn't ours to close.
+func (r *interceptingReader) Close() error {
+	return nil
+}
+
+func (r *interceptingReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for {
+		if len(r.pending) > 0 {
+			n := copy(p, r.pending)
+			r.pending = r.pending[n:]
+			return n, nil
+		}
+
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+
+		switch b {
+		case 4: // Ctrl+D - switch to dashboard mode
+			r.h.commands <- CmdModeDashboard
+		case 14: // Ctrl+N - new tab
+			r.h.commands <- CmdTabNew
+		case 20: // Ctrl+T - toggle between modes
+			r.h.commands <- CmdModeToggle
+		case 23: // Ctrl+W - close tab
+			r.h.commands <- CmdTabClose
+		case 27: // Escape sequence
+			r.handleEscape()
+		default:
+			p[0] = b
+			return 1, nil
+		}
+	}
+}
+
+func (r *interceptingReader) readByte() (byte, error) {
+	var buf [1]byte
+	for {
+		n, err := r.src.Read(buf[:])
+		if n > 0 {
+			return buf[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// handleEscape consumes and interprets what follows a bare ESC byte:
+// Alt+1..9 (tab jump), Alt+Left/Right (CSI "1;3D"/"1;3C" - headline nav),
+// Alt+Up/Down (CSI "1;3A"/"1;3B" - dashboard panel grow/shrink), Alt+H/V
+// (dashboard panel orientation flip), Alt+Enter (continue composing a
+// multiline message instead of submitting), Ctrl+Tab/Ctrl+Shift+Tab (CSI
+// "1;5I"/"1;6I"), SGR mouse reports (CSI "<..."), and bracketed paste (CSI
+// "200~"..."201~"). Alt+Up/Down and Alt+H/V were picked deliberately:
+// Ctrl+Arrow is readline's own word-navigation and Ctrl+H is the
+// terminal-universal Backspace, so both would have collided. Anything else
+// is queued on pending so it reaches the line editor exactly as it arrived
+// - readline needs the real escape sequences for plain arrows, Home/End,
+// Delete, and its own Alt-based word navigation.
+func (r *interceptingReader) handleEscape() {
+	next, err := r.readByte()
+	if err != nil {
+		r.pending = append(r.pending, 27)
+		return
+	}
+
+	if next >= '1' && next <= '9' { // Alt+1..9 - jump to tab
+		select {
+		case r.h.tabJumps <- int(next - '0'):
+		default:
+		}
+		return
+	}
+
+	if next != '[' {
+		switch next {
+		case 'h', 'H', 'v', 'V': // Alt+H/V - flip dashboard panel orientation
+			r.h.commands <- CmdFlipOrientation
+			return
+		case '\r', '\n': // Alt+Enter - continue composing instead of submitting
+			r.pending = append(r.pending, '\\', next)
+			return
+		}
+		r.pending = append(r.pending, 27, next)
+		return
+	}
+
+	b2, err := r.readByte()
+	if err != nil {
+		r.pending = append(r.pending, 27, '[')
+		return
+	}
+
+	switch b2 {
+	case '<': // CSI "<Cb;Cx;Cy(M|m)" - SGR mouse report
+		r.readSGRMouse()
+		return
+
+	case '1': // possibly "1;3D"/"1;3C" (Alt+Left/Right) or "1;5I"/"1;6I" (Ctrl-Tab)
+		rest := make([]byte, 3)
+		n := 0
+		for ; n < len(rest); n++ {
+			b, err := r.readByte()
+			if err != nil {
+				break
+			}
+			rest[n] = b
+		}
+
+		if n == 3 && rest[0] == ';' {
+			switch {
+			case rest[1] == '3' && rest[2] == 'D':
+				r.h.commands <- CmdPrevHeadline
+				return
+			case rest[1] == '3' && rest[2] == 'C':
+				r.h.commands <- CmdNextHeadline
+				return
+			case rest[1] == '5' && rest[2] == 'I':
+				r.h.commands <- CmdTabNext
+				return
+			case rest[1] == '6' && rest[2] == 'I':
+				r.h.commands <- CmdTabPrev
+				return
+			case rest[1] == '3' && rest[2] == 'A':
+				r.h.commands <- CmdPanelGrow
+				return
+			case rest[1] == '3' && rest[2] == 'B':
+				r.h.commands <- CmdPanelShrink
+				return
+			}
+		}
+
+		r.pending = append(r.pending, 27, '[', '1')
+		r.pending = append(r.pending, rest[:n]...)
+
+	case '2': // possibly "200~" - bracketed paste start
+		rest := make([]byte, 3)
+		n := 0
+		for ; n < len(rest); n++ {
+			b, err := r.readByte()
+			if err != nil {
+				break
+			}
+			rest[n] = b
+		}
+
+		if n == 3 && rest[0] == '0' && rest[1] == '0' && rest[2] == '~' {
+			r.readBracketedPaste()
+			return
+		}
+
+		r.pending = append(r.pending, 27, '[', '2')
+		r.pending = append(r.pending, rest[:n]...)
+
+	case 'I': // bare CSI I - some terminals send this for Tab
+		r.h.commands <- CmdTabNext
+
+	default:
+		// Plain arrow keys, Home/End, Delete, etc - readline's own job.
+		r.pending = append(r.pending, 27, '[', b2)
+	}
+}
+
+// readBracketedPaste reads the remainder of a bracketed paste (everything
+// after "ESC[200~") up to its "ESC[201~" terminator. A paste containing a
+// newline is sent straight to h.messages as a single atomic message, since
+// letting it flow through pending would mangle it into several submitted
+// lines; a single-line paste is instead queued on pending so it's inserted
+// into the current line like ordinary typed text.
+func (r *interceptingReader) readBracketedPaste() {
+	var content []byte
+
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			break
+		}
+		if b != 27 {
+			content = append(content, b)
+			continue
+		}
+
+		rest := make([]byte, 5)
+		n := 0
+		for ; n < len(rest); n++ {
+			b, err := r.readByte()
+			if err != nil {
+				break
+			}
+			rest[n] = b
+		}
+
+		if n == 5 && rest[0] == '[' && rest[1] == '2' && rest[2] == '0' && rest[3] == '1' && rest[4] == '~' {
+			break
+		}
+
+		content = append(content, 27)
+		content = append(content, rest[:n]...)
+	}
+
+	if strings.ContainsAny(string(content), "\r\n") {
+		select {
+		case r.h.messages <- string(content):
+		default:
+		}
+		return
+	}
+
+	r.pending = append(r.pending, content...)
+}
+
+// readSGRMouse reads the remainder of an SGR mouse report (everything after
+// "ESC[<") and hands the parsed result to h.parseSGRMouse.
+func (r *interceptingReader) readSGRMouse() {
+	var raw strings.Builder
+
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return
+		}
+		if b == 'M' || b == 'm' {
+			r.h.parseSGRMouse(raw.String(), b == 'm')
+			return
+		}
+		raw.WriteByte(b)
+	}
+}
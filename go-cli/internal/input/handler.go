@@ -2,11 +2,14 @@ package input
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
-	"golang.org/x/term"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/chzyer/readline"
 )
 
 // Command represents a user command
@@ -22,53 +25,131 @@ const (
 	CmdModeDashboard
 	CmdModeMinimal
 	CmdModeToggle
+	CmdTabNew
+	CmdTabClose
+	CmdTabNext
+	CmdTabPrev
+	CmdSpinnerChange
+	CmdPanelGrow
+	CmdPanelShrink
+	CmdFlipOrientation
+	CmdModeFocus
+	CmdKeymapChange
+	CmdComposeUpdate
 )
 
+// MouseButton identifies which button (or wheel direction) a MouseEvent
+// reports.
+type MouseButton int
+
+const (
+	MouseNone MouseButton = iota
+	MouseLeft
+	MouseRight
+	MouseMiddle
+	MouseWheelUp
+	MouseWheelDown
+)
+
+// MouseEvent is a parsed xterm SGR mouse report. X and Y are 0-indexed
+// screen coordinates, matching Layout's rect fields.
+type MouseEvent struct {
+	X, Y   int
+	Button MouseButton
+	Shift  bool
+}
+
+// enableMouseTracking turns on xterm SGR mouse reporting (clicks and the
+// scroll wheel). disableMouseTracking restores the terminal's normal mode.
+const enableMouseTracking = "\033[?1000h\033[?1006h"
+const disableMouseTracking = "\033[?1000l\033[?1006l"
+
+// enableBracketedPaste wraps pasted text in "\033[200~"..."\033[201~" so
+// interceptingReader can capture it atomically instead of it streaming in
+// keystroke-by-keystroke indistinguishable from typing (which would mangle
+// multiline pastes and fire one submit per embedded newline).
+// disableBracketedPaste restores the terminal's normal paste behavior.
+const enableBracketedPaste = "\033[?2004h"
+const disableBracketedPaste = "\033[?2004l"
+
 // Handler manages user input
 type Handler struct {
-	oldState *term.State
-
-	commands chan Command
-	messages chan string
-	stop     chan bool
+	rl     *readline.Instance
+	keymap string // "emacs" or "vi"; applied when rl is created, and live-switchable via SetKeymap
+
+	commands      chan Command
+	messages      chan string
+	tabJumps      chan int
+	mouseEvents   chan MouseEvent
+	spinnerChoice chan string
+	focusChoice   chan string
+	keymapChoice  chan string
+	composeUpdate chan string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     *shutdown.WaitGroup
 }
 
-// New creates a new input handler
+// New creates a new input handler using Emacs-style editing (Ctrl-A/E/K/W,
+// Alt-B/F word motion) by default.
 func New() *Handler {
 	return &Handler{
-		commands: make(chan Command, 10),
-		messages: make(chan string, 10),
-		stop:     make(chan bool),
+		keymap:        "emacs",
+		commands:      make(chan Command, 10),
+		messages:      make(chan string, 10),
+		tabJumps:      make(chan int, 10),
+		mouseEvents:   make(chan MouseEvent, 10),
+		spinnerChoice: make(chan string, 1),
+		focusChoice:   make(chan string, 1),
+		keymapChoice:  make(chan string, 1),
+		composeUpdate: make(chan string, 1),
+		ctx:           context.Background(),
 	}
 }
 
-// Start begins handling input
-func (h *Handler) Start() error {
-	// Put terminal in raw mode to capture arrow keys
-	var err error
-	h.oldState, err = term.MakeRaw(int(os.Stdin.Fd()))
+// Start begins handling input. The read loop registers with wg as
+// "input-handler" and exits once ctx is cancelled (by Close, or a parent
+// shutdown) instead of relying on a stop channel that panics if closed
+// twice.
+func (h *Handler) Start(ctx context.Context, wg *shutdown.WaitGroup) error {
+	ctx, cancel := context.WithCancel(ctx)
+	h.ctx = ctx
+	h.cancel = cancel
+	h.wg = wg
+
+	rl, err := newLineEditor(h)
 	if err != nil {
-		// Fallback to cooked mode if raw mode fails
+		// Fallback to cooked mode if the line editor can't take over the
+		// terminal (e.g. stdin isn't a TTY).
 		return h.startCookedMode()
 	}
+	h.rl = rl
 
-	go h.readRawInput()
+	fmt.Print(enableMouseTracking)
+	fmt.Print(enableBracketedPaste)
+
+	wg.Add("input-handler")
+	go h.runLineEditor()
 	return nil
 }
 
 // startCookedMode starts input handling in cooked mode (fallback)
 func (h *Handler) startCookedMode() error {
+	h.wg.Add("input-handler")
 	go h.readCookedInput()
 	return nil
 }
 
 // readCookedInput reads input in cooked mode (line-buffered)
 func (h *Handler) readCookedInput() {
+	defer h.wg.Done("input-handler")
+
 	scanner := bufio.NewScanner(os.Stdin)
 
 	for scanner.Scan() {
 		select {
-		case <-h.stop:
+		case <-h.ctx.Done():
 			return
 		default:
 		}
@@ -78,85 +159,177 @@ func (h *Handler) readCookedInput() {
 	}
 }
 
-// readRawInput reads input in raw mode (character-by-character)
-func (h *Handler) readRawInput() {
-	buf := make([]byte, 1)
-	var currentInput strings.Builder
-
-	// Track cursor position for proper input display
-	inputX := 2 // Starting position after "> "
+// composePrompt replaces the normal prompt while a multiline message is
+// being composed, so the user can tell they're adding a continuation line
+// rather than submitting.
+const composePrompt = "... "
+
+// runLineEditor drives the readline-backed editor until it errors out or
+// ctx is cancelled. Keystrokes that belong to the app rather than the line
+// being edited (Ctrl+D/N/T/W, Alt+digit tab jumps, Alt+Left/Right headline
+// nav, SGR mouse reports) never reach here - interceptingReader, installed
+// as the editor's Stdin, strips them out and dispatches them directly.
+//
+// A line ending in a trailing "\" (typed directly, or synthesized by
+// interceptingReader from Alt+Enter) doesn't submit - it's stashed as a
+// continuation and the prompt switches to composePrompt until a line
+// without the trailing "\" closes the message out, at which point all of
+// them are joined with "\n" and submitted as one message. Ctrl+C while
+// composing cancels it instead of quitting.
+func (h *Handler) runLineEditor() {
+	defer h.wg.Done("input-handler")
+	defer h.rl.Close()
+
+	var composing []string
 
 	for {
 		select {
-		case <-h.stop:
+		case <-h.ctx.Done():
 			return
 		default:
 		}
 
-		n, err := os.Stdin.Read(buf)
-		if err != nil || n == 0 {
-			continue
-		}
-
-		ch := buf[0]
-
-		// Handle special keys
-		switch ch {
-		case 3: // Ctrl+C
+		line, err := h.rl.Readline()
+		switch err {
+		case readline.ErrInterrupt: // Ctrl+C
+			if len(composing) > 0 {
+				composing = nil
+				h.rl.SetPrompt("> ")
+				h.sendComposeUpdate("")
+				continue
+			}
 			h.commands <- CmdQuit
 			return
-
-		case 4: // Ctrl+D - Switch to dashboard mode
-			h.commands <- CmdModeDashboard
-			continue
-
-		case 10, 13: // Enter/Return (both LF and CR)
-			line := strings.TrimSpace(currentInput.String())
-			if line != "" {
-				// Clear the input line visually
-				fmt.Print("\r> " + strings.Repeat(" ", currentInput.Len()) + "\r> ")
-				h.processInput(line)
+		case io.EOF:
+			h.commands <- CmdQuit
+			return
+		case nil:
+			if rest, ok := strings.CutSuffix(strings.TrimRight(line, " \t"), "\\"); ok {
+				composing = append(composing, rest)
+				h.rl.SetPrompt(composePrompt)
+				h.sendComposeUpdate(strings.Join(composing, "\n"))
+				continue
 			}
-			currentInput.Reset()
-			inputX = 2
 
-		case 20: // Ctrl+T - Toggle between modes
-			h.commands <- CmdModeToggle
-			continue
-
-		case 127, 8: // Backspace/Delete
-			if currentInput.Len() > 0 {
-				s := currentInput.String()
-				currentInput.Reset()
-				currentInput.WriteString(s[:len(s)-1])
-				inputX--
-				// Clear the character and move cursor back
-				fmt.Print("\b \b")
+			line = strings.TrimSpace(line)
+			if len(composing) > 0 {
+				composing = append(composing, line)
+				full := strings.Join(composing, "\n")
+				composing = nil
+				h.rl.SetPrompt("> ")
+				h.sendComposeUpdate("")
+				h.processInput(full)
+				continue
 			}
 
-		case 27: // Escape sequence (arrow keys)
-			// Read next two bytes for arrow key
-			buf2 := make([]byte, 2)
-			n, _ := os.Stdin.Read(buf2)
-			if n == 2 && buf2[0] == '[' {
-				switch buf2[1] {
-				case 'C': // Right arrow
-					h.commands <- CmdNextHeadline
-				case 'D': // Left arrow
-					h.commands <- CmdPrevHeadline
-				}
-			}
+			h.processInput(line)
+		default:
+			return
+		}
+	}
+}
 
+// sendComposeUpdate reports the current state of an in-progress multiline
+// composition (text == "" means composition ended or was cancelled),
+// overwriting any update that hasn't been picked up yet - only the latest
+// matters for display.
+func (h *Handler) sendComposeUpdate(text string) {
+	select {
+	case h.composeUpdate <- text:
+	default:
+		select {
+		case <-h.composeUpdate:
 		default:
-			// Regular character
-			if ch >= 32 && ch < 127 {
-				currentInput.WriteByte(ch)
-				inputX++
-				// Echo the character
-				fmt.Printf("%c", ch)
-			}
+		}
+		h.composeUpdate <- text
+	}
+	select {
+	case h.commands <- CmdComposeUpdate:
+	default:
+	}
+}
+
+// SetKeymap switches between "emacs" (the default) and "vi" editing, live if
+// the line editor is already running. Returns an error for any other name,
+// leaving the current keymap untouched.
+func (h *Handler) SetKeymap(name string) error {
+	switch name {
+	case "emacs":
+		h.keymap = name
+		if h.rl != nil {
+			h.rl.SetVimMode(false)
+		}
+	case "vi":
+		h.keymap = name
+		if h.rl != nil {
+			h.rl.SetVimMode(true)
+		}
+	default:
+		return fmt.Errorf("unknown keymap: %s", name)
+	}
+	return nil
+}
+
+// RefreshLine repaints the line editor's prompt and current buffer. Call it
+// after any full-screen redraw that clears the input row out from under it
+// (e.g. a background weather/news update), so the user's in-progress input
+// reappears instead of staying blanked out. A no-op before Start or in
+// cooked-mode fallback.
+func (h *Handler) RefreshLine() {
+	if h.rl != nil {
+		h.rl.Refresh()
+	}
+}
+
+// parseSGRMouse turns the "Cb;Cx;Cy" portion of an SGR mouse report into a
+// MouseEvent and delivers it. release is true for button-up ('m') reports.
+func (h *Handler) parseSGRMouse(params string, release bool) {
+	parts := strings.Split(params, ";")
+	if len(parts) != 3 {
+		return
+	}
+
+	var cb, x, y int
+	if _, err := fmt.Sscanf(parts[0], "%d", &cb); err != nil {
+		return
+	}
+	if _, err := fmt.Sscanf(parts[1], "%d", &x); err != nil {
+		return
+	}
+	if _, err := fmt.Sscanf(parts[2], "%d", &y); err != nil {
+		return
+	}
+
+	ev := MouseEvent{
+		X:     x - 1, // SGR coordinates are 1-indexed
+		Y:     y - 1,
+		Shift: cb&4 != 0,
+	}
+
+	switch {
+	case release:
+		ev.Button = MouseNone
+	case cb&64 != 0: // wheel events are flagged in bit 6
+		if cb&1 != 0 {
+			ev.Button = MouseWheelDown
+		} else {
+			ev.Button = MouseWheelUp
+		}
+	default:
+		switch cb & 3 {
+		case 0:
+			ev.Button = MouseLeft
+		case 1:
+			ev.Button = MouseMiddle
+		case 2:
+			ev.Button = MouseRight
 		}
 	}
+
+	select {
+	case h.mouseEvents <- ev:
+	default:
+	}
 }
 
 // processInput processes a line of input
@@ -184,7 +357,39 @@ func (h *Handler) processInput(line string) {
 			h.commands <- CmdModeMinimal
 		case "/mode toggle", "/toggle":
 			h.commands <- CmdModeToggle
+		case "/tab new":
+			h.commands <- CmdTabNew
+		case "/tab close":
+			h.commands <- CmdTabClose
+		case "/tab next":
+			h.commands <- CmdTabNext
+		case "/tab prev":
+			h.commands <- CmdTabPrev
 		default:
+			if choice, ok := strings.CutPrefix(cmd, "/spinner "); ok {
+				select {
+				case h.spinnerChoice <- choice:
+				default:
+				}
+				h.commands <- CmdSpinnerChange
+				return
+			}
+			if choice, ok := strings.CutPrefix(cmd, "/focus "); ok {
+				select {
+				case h.focusChoice <- choice:
+				default:
+				}
+				h.commands <- CmdModeFocus
+				return
+			}
+			if choice, ok := strings.CutPrefix(cmd, "/keymap "); ok {
+				select {
+				case h.keymapChoice <- choice:
+				default:
+				}
+				h.commands <- CmdKeymapChange
+				return
+			}
 			// Unknown command
 			fmt.Printf("\r\nUnknown command: %s\r\n", line)
 		}
@@ -209,12 +414,58 @@ func (h *Handler) Messages() <-chan string {
 	return h.messages
 }
 
-// Close closes the input handler and restores terminal state
+// TabJumps returns the channel for receiving Alt+1..9 jump-to-tab requests.
+// It carries the 1-indexed tab number rather than a Command, since Command
+// has no payload.
+func (h *Handler) TabJumps() <-chan int {
+	return h.tabJumps
+}
+
+// MouseEvents returns the channel for receiving parsed mouse click, drag,
+// and scroll-wheel reports.
+func (h *Handler) MouseEvents() <-chan MouseEvent {
+	return h.mouseEvents
+}
+
+// SpinnerChoice returns the channel carrying the argument of the most
+// recent "/spinner <name>" command (e.g. "ascii"), delivered alongside a
+// CmdSpinnerChange on Commands().
+func (h *Handler) SpinnerChoice() <-chan string {
+	return h.spinnerChoice
+}
+
+// FocusChoice returns the channel carrying the argument of the most recent
+// "/focus <name>" command (e.g. "focus-chat"), delivered alongside a
+// CmdModeFocus on Commands().
+func (h *Handler) FocusChoice() <-chan string {
+	return h.focusChoice
+}
+
+// KeymapChoice returns the channel carrying the argument of the most recent
+// "/keymap <name>" command ("vi" or "emacs"), delivered alongside a
+// CmdKeymapChange on Commands().
+func (h *Handler) KeymapChoice() <-chan string {
+	return h.keymapChoice
+}
+
+// ComposeUpdate returns the channel carrying the current text of an
+// in-progress multiline composition (empty once it ends or is cancelled),
+// delivered alongside a CmdComposeUpdate on Commands().
+func (h *Handler) ComposeUpdate() <-chan string {
+	return h.composeUpdate
+}
+
+// Close closes the input handler and restores terminal state. It's safe to
+// call more than once.
 func (h *Handler) Close() error {
-	close(h.stop)
+	if h.cancel != nil {
+		h.cancel()
+	}
+	fmt.Print(disableMouseTracking)
+	fmt.Print(disableBracketedPaste)
 
-	if h.oldState != nil {
-		return term.Restore(int(os.Stdin.Fd()), h.oldState)
+	if h.rl != nil {
+		return h.rl.Close()
 	}
 
 	return nil
@@ -0,0 +1,163 @@
+// Package ipc exposes a small local control socket so PACE can be driven
+// from outside its own terminal - shell scripts, tmux hooks, or
+// window-manager keybinds - without stealing the TTY. Clients (e.g.
+// cmd/pacectl) speak one JSON request per line and get back one JSON
+// response per line.
+package ipc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+)
+
+// Request is one line of the control protocol. Command is the verb
+// ("refresh", "mode", "send", "status", "quit"); Args carries whatever
+// follows it, split on whitespace by the caller.
+type Request struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+}
+
+// StatusData is the payload returned for a "status" request.
+type StatusData struct {
+	ConnState client.ConnectionState `json:"conn_state"`
+	Reconnect client.ReconnectStatus `json:"reconnect,omitempty"`
+	Weather   managers.WeatherData   `json:"weather"`
+	News      managers.NewsData      `json:"news"`
+	Time      managers.TimeData      `json:"time"`
+}
+
+// Response is the reply to a Request. Error is set (and OK false) if the
+// command was malformed or failed; Status is only set for "status".
+type Response struct {
+	OK     bool        `json:"ok"`
+	Error  string      `json:"error,omitempty"`
+	Status *StatusData `json:"status,omitempty"`
+}
+
+// Envelope pairs a parsed Request with a channel to deliver its Response on,
+// so the app's event loop can reply without knowing anything about the
+// underlying connection.
+type Envelope struct {
+	Request Request
+	Reply   chan<- Response
+}
+
+// Server listens on the platform's local control socket (a Unix domain
+// socket, or a named pipe on Windows - see listen in listener_unix.go /
+// listener_windows.go) and turns each incoming line into an Envelope on its
+// Requests channel.
+type Server struct {
+	listener net.Listener
+
+	requests chan *Envelope
+	wg       *shutdown.WaitGroup
+	cancel   context.CancelFunc
+}
+
+// NewServer creates a Server that hasn't started listening yet.
+func NewServer() *Server {
+	return &Server{requests: make(chan *Envelope, 10)}
+}
+
+// Start opens the control socket and begins accepting connections. Each
+// connection, and the accept loop itself, registers with wg so a shutdown
+// can wait for in-flight requests to finish instead of cutting them off.
+func (s *Server) Start(ctx context.Context, wg *shutdown.WaitGroup) error {
+	path, err := SocketPath()
+	if err != nil {
+		return err
+	}
+
+	ln, err := listen(path)
+	if err != nil {
+		return err
+	}
+	s.listener = ln
+
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.wg = wg
+
+	wg.Add("ipc-server")
+	go func() {
+		defer wg.Done("ipc-server")
+		defer ln.Close()
+
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+					logging.Get("ipc").Warn("accept error", "error", err)
+					return
+				}
+			}
+
+			wg.Add("ipc-conn")
+			go func() {
+				defer wg.Done("ipc-conn")
+				s.handleConn(ctx, conn)
+			}()
+		}
+	}()
+
+	return nil
+}
+
+// handleConn serves requests from one client connection until it closes the
+// connection or the server is shutting down.
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	enc := json.NewEncoder(conn)
+
+	for scanner.Scan() {
+		var req Request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			enc.Encode(Response{Error: err.Error()})
+			continue
+		}
+
+		reply := make(chan Response, 1)
+		select {
+		case s.requests <- &Envelope{Request: req, Reply: reply}:
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case resp := <-reply:
+			enc.Encode(resp)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Requests returns the channel of incoming control commands, e.g. for the
+// app's event loop to select on alongside its other channels.
+func (s *Server) Requests() <-chan *Envelope {
+	return s.requests
+}
+
+// Close stops accepting new connections. It's safe to call more than once.
+func (s *Server) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.listener != nil {
+		return s.listener.Close()
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+//go:build !windows
+
+package ipc
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// SocketPath returns the Unix domain socket path the server listens on and
+// clients connect to: $XDG_RUNTIME_DIR/pace/pace.sock, falling back to
+// $TMPDIR/pace if the runtime dir isn't set.
+func SocketPath() (string, error) {
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = os.TempDir()
+	}
+	return filepath.Join(runtimeDir, "pace", "pace.sock"), nil
+}
+
+// Dial connects to a running server's control socket, for clients like
+// cmd/pacectl.
+func Dial(path string) (net.Conn, error) {
+	return net.Dial("unix", path)
+}
+
+// listen opens the control socket at path, removing a stale socket file left
+// behind by a previous run that didn't exit cleanly.
+func listen(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create socket directory: %w", err)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", path, err)
+	}
+	return ln, nil
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package ipc
+
+import (
+	"net"
+
+	"github.com/Microsoft/go-winio"
+)
+
+// pacePipeName is the well-known named pipe PACE listens on for control
+// connections on Windows, where Unix domain sockets (used by listener_unix.go)
+// aren't available.
+const pacePipeName = `\\.\pipe\pace`
+
+// SocketPath returns the named pipe path the server listens on and clients
+// connect to.
+func SocketPath() (string, error) {
+	return pacePipeName, nil
+}
+
+// listen opens the control pipe. Requires github.com/Microsoft/go-winio,
+// which - like github.com/gdamore/tcell/v2 for the tcell-tagged backend -
+// isn't a default dependency of this module; build with `-tags windows`
+// once it's vendored.
+func listen(path string) (net.Listener, error) {
+	return winio.ListenPipe(path, nil)
+}
+
+// Dial connects to a running server's control pipe, for clients like
+// cmd/pacectl.
+func Dial(path string) (net.Conn, error) {
+	return winio.DialPipe(path, nil)
+}
@@ -0,0 +1,85 @@
+// Package logging provides named, per-subsystem loggers ("client",
+// "weather", "news", "input", "ui", ...) built on log/slog, so noisy
+// subsystems (e.g. reconnect chatter) can be filtered or routed to a
+// rotating log file instead of polluting the terminal UI.
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Options configures Init. Sink selects where log records go: "file" writes
+// to a RotatingFile at File (rotating by MaxSizeMB/MaxAgeDays/MaxBackups),
+// anything else (including "" or "console") writes to stderr.
+type Options struct {
+	Level      string
+	Sink       string
+	File       string
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+}
+
+var (
+	mu   sync.RWMutex
+	root = slog.New(slog.NewTextHandler(os.Stderr, nil))
+)
+
+// Init configures the root logger all subsystem loggers derive from. It's
+// safe to call again later (e.g. after a config reload) to change sinks or
+// level; existing *slog.Logger values returned by Get before the change keep
+// logging to the old sink, since slog.Logger is immutable - callers should
+// fetch a fresh logger via Get() at each log call site rather than caching
+// one at package-init time.
+func Init(opts Options) error {
+	level := parseLevel(opts.Level)
+
+	var w io.Writer
+	if opts.Sink == "file" {
+		path := opts.File
+		if path == "" {
+			path = defaultLogPath()
+		}
+		rf := NewRotatingFile(path, opts.MaxSizeMB, opts.MaxAgeDays, opts.MaxBackups)
+		if err := rf.Open(); err != nil {
+			return err
+		}
+		w = rf
+	} else {
+		w = os.Stderr
+	}
+
+	handler := slog.NewTextHandler(w, &slog.HandlerOptions{Level: level})
+
+	mu.Lock()
+	root = slog.New(handler)
+	mu.Unlock()
+
+	return nil
+}
+
+// Get returns a logger for the named subsystem (e.g. "client", "weather"),
+// tagged with a "subsystem" attribute so a single log file can still be
+// filtered per component.
+func Get(subsystem string) *slog.Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return root.With("subsystem", subsystem)
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,174 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+)
+
+const (
+	defaultMaxSizeMB  = 10
+	defaultMaxAgeDays = 7
+	defaultMaxBackups = 3
+)
+
+// RotatingFile is an io.WriteCloser that writes to Path, rotating to a
+// timestamped backup once the file grows past MaxSizeMB, and pruning
+// backups older than MaxAgeDays or beyond MaxBackups - the same knobs
+// lumberjack.Logger exposes, reimplemented here rather than vendoring it
+// since it isn't a default dependency of this module.
+type RotatingFile struct {
+	Path       string
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+
+	f    *os.File
+	size int64
+}
+
+// NewRotatingFile creates a RotatingFile with defaults filled in for any
+// zero-valued size/age/backup limit.
+func NewRotatingFile(path string, maxSizeMB, maxAgeDays, maxBackups int) *RotatingFile {
+	if maxSizeMB <= 0 {
+		maxSizeMB = defaultMaxSizeMB
+	}
+	if maxAgeDays <= 0 {
+		maxAgeDays = defaultMaxAgeDays
+	}
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxBackups
+	}
+	return &RotatingFile{Path: path, MaxSizeMB: maxSizeMB, MaxAgeDays: maxAgeDays, MaxBackups: maxBackups}
+}
+
+// Open creates the log directory if needed and opens (or creates) Path for
+// appending.
+func (r *RotatingFile) Open() error {
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	f, err := os.OpenFile(r.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	r.f = f
+	r.size = info.Size()
+	return nil
+}
+
+// Write implements io.Writer, rotating first if p would push the file past
+// MaxSizeMB.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	if r.f == nil {
+		if err := r.Open(); err != nil {
+			return 0, err
+		}
+	}
+
+	maxBytes := int64(r.MaxSizeMB) * 1024 * 1024
+	if r.size+int64(len(p)) > maxBytes {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the current log file.
+func (r *RotatingFile) Close() error {
+	if r.f == nil {
+		return nil
+	}
+	return r.f.Close()
+}
+
+// rotate closes the current file, renames it to a timestamped backup, opens
+// a fresh file at Path, and prunes old backups.
+func (r *RotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+
+	backup := fmt.Sprintf("%s.%s", r.Path, time.Now().Format("20060102-150405"))
+	if err := os.Rename(r.Path, backup); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+
+	if err := r.Open(); err != nil {
+		return err
+	}
+
+	return r.pruneBackups()
+}
+
+// pruneBackups removes backups older than MaxAgeDays, then trims down to
+// MaxBackups if more remain, oldest first.
+func (r *RotatingFile) pruneBackups() error {
+	matches, err := filepath.Glob(r.Path + ".*")
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -r.MaxAgeDays)
+	var kept []string
+	for _, path := range matches {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(cutoff) {
+			os.Remove(path)
+			continue
+		}
+		kept = append(kept, path)
+	}
+
+	sort.Strings(kept) // timestamp suffix sorts chronologically
+	if excess := len(kept) - r.MaxBackups; excess > 0 {
+		for _, path := range kept[:excess] {
+			os.Remove(path)
+		}
+	}
+
+	return nil
+}
+
+// defaultLogPath returns the platform-conventional location for pace.log,
+// mirroring config.getConfigPath's choice of directory.
+func defaultLogPath() string {
+	var dir string
+
+	switch runtime.GOOS {
+	case "windows":
+		if appData := os.Getenv("APPDATA"); appData != "" {
+			dir = filepath.Join(appData, "pace")
+		}
+	default:
+		if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+			dir = filepath.Join(xdg, "pace")
+		} else if home, err := os.UserHomeDir(); err == nil {
+			dir = filepath.Join(home, ".pace")
+		}
+	}
+
+	if dir == "" {
+		dir = "."
+	}
+
+	return filepath.Join(dir, "pace.log")
+}
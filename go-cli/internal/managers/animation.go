@@ -0,0 +1,90 @@
+package managers
+
+import "time"
+
+// LoadingAnimation supplies the frames ConversationManager's loading
+// indicator cycles through while a query is in flight. Frame is called once
+// per tick with a monotonically increasing counter (not a timestamp) so an
+// implementation can derive its own sub-cycles (e.g. spinner glyph vs.
+// message text) without tracking time itself.
+type LoadingAnimation interface {
+	// Frame returns the text to display for the given tick.
+	Frame(tick int) string
+
+	// Interval is how often Frame should be called.
+	Interval() time.Duration
+}
+
+// BrailleSpinner is the original loading animation: a Braille spinner
+// combined with a cycling "Thinking...", "Processing...", etc. message. It
+// needs Unicode support and is the default on terminals that have it.
+type BrailleSpinner struct {
+	messages []string
+}
+
+// NewBrailleSpinner creates a BrailleSpinner with the default message set.
+func NewBrailleSpinner() *BrailleSpinner {
+	states := []string{"Thinking", "Processing", "Analyzing", "Searching"}
+
+	messages := make([]string, 0, len(states)*4)
+	for _, state := range states {
+		for i := 0; i <= 3; i++ {
+			messages = append(messages, state+dots(i))
+		}
+	}
+
+	return &BrailleSpinner{messages: messages}
+}
+
+var brailleFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+func (s *BrailleSpinner) Frame(tick int) string {
+	spinnerIdx := tick % len(brailleFrames)
+	msgIdx := (tick / len(brailleFrames)) % len(s.messages)
+	return brailleFrames[spinnerIdx] + " " + s.messages[msgIdx]
+}
+
+func (s *BrailleSpinner) Interval() time.Duration {
+	return 150 * time.Millisecond
+}
+
+// AsciiSpinner is a LoadingAnimation that only uses the four classic ASCII
+// spinner glyphs, for terminals DetectCapabilities flags as non-Unicode
+// (TERM=dumb, a non-UTF-8 LANG).
+type AsciiSpinner struct{}
+
+var asciiFrames = []string{"|", "/", "-", "\\"}
+
+func (AsciiSpinner) Frame(tick int) string {
+	return asciiFrames[tick%len(asciiFrames)]
+}
+
+func (AsciiSpinner) Interval() time.Duration {
+	return 150 * time.Millisecond
+}
+
+// DotsAnimation is a LoadingAnimation built entirely from ASCII dots, for
+// terminals where even the ASCII spinner glyphs might look odd.
+type DotsAnimation struct{}
+
+func (DotsAnimation) Frame(tick int) string {
+	return "Loading" + dots(tick%4)
+}
+
+func (DotsAnimation) Interval() time.Duration {
+	return 400 * time.Millisecond
+}
+
+// dots returns n repeated "." characters, n in [0, 3].
+func dots(n int) string {
+	switch n {
+	case 1:
+		return "."
+	case 2:
+		return ".."
+	case 3:
+		return "..."
+	default:
+		return ""
+	}
+}
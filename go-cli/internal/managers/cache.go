@@ -0,0 +1,34 @@
+package managers
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// cacheDir returns the platform-conventional cache directory for PACE's
+// disk caches (weather.json, news.json) - distinct from config.getConfigPath's
+// directory, since this content is disposable and shouldn't live next to
+// config.yaml.
+func cacheDir() string {
+	var dir string
+
+	switch runtime.GOOS {
+	case "windows":
+		if appData := os.Getenv("LOCALAPPDATA"); appData != "" {
+			dir = filepath.Join(appData, "pace")
+		}
+	default:
+		if xdg := os.Getenv("XDG_CACHE_HOME"); xdg != "" {
+			dir = filepath.Join(xdg, "pace")
+		} else if home, err := os.UserHomeDir(); err == nil {
+			dir = filepath.Join(home, ".cache", "pace")
+		}
+	}
+
+	if dir == "" {
+		dir = "."
+	}
+
+	return dir
+}
@@ -1,9 +1,11 @@
 package managers
 
 import (
-	"strings"
+	"context"
 	"sync"
 	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
 )
 
 // ConversationData represents conversation state
@@ -19,20 +21,64 @@ type ConversationData struct {
 
 // ConversationManager manages conversation state
 type ConversationManager struct {
-	data    ConversationData
-	mu      sync.RWMutex
-	timeout time.Duration
+	data      ConversationData
+	mu        sync.RWMutex
+	timeout   time.Duration
+	animation LoadingAnimation
 
 	updates       chan ConversationData
 	stopAnimation chan bool
+
+	ctx    context.Context
+	wg     *shutdown.WaitGroup
+	cancel context.CancelFunc
 }
 
-// NewConversationManager creates a new ConversationManager
-func NewConversationManager(timeout time.Duration) *ConversationManager {
+// NewConversationManager creates a new ConversationManager. animation drives
+// the indicator shown while Processing is true; a nil animation falls back
+// to BrailleSpinner.
+func NewConversationManager(timeout time.Duration, animation LoadingAnimation) *ConversationManager {
+	if animation == nil {
+		animation = NewBrailleSpinner()
+	}
+
 	return &ConversationManager{
 		timeout:       timeout,
+		animation:     animation,
 		updates:       make(chan ConversationData, 1),
 		stopAnimation: make(chan bool, 1),
+		ctx:           context.Background(),
+	}
+}
+
+// SetAnimation swaps the loading animation live, e.g. from a "/spinner"
+// input command. It takes effect on the next tick of any animation already
+// in flight.
+func (cm *ConversationManager) SetAnimation(animation LoadingAnimation) {
+	if animation == nil {
+		return
+	}
+	cm.mu.Lock()
+	cm.animation = animation
+	cm.mu.Unlock()
+}
+
+// Start registers the ConversationManager with the app's shutdown
+// coordination. Each goroutine it spawns afterwards (loading animation,
+// response streaming, the query timeout) registers with wg and exits early
+// if ctx is cancelled, instead of being left to drop its final update.
+func (cm *ConversationManager) Start(ctx context.Context, wg *shutdown.WaitGroup) {
+	ctx, cancel := context.WithCancel(ctx)
+	cm.ctx = ctx
+	cm.cancel = cancel
+	cm.wg = wg
+}
+
+// Stop cancels any goroutines currently in flight. It's safe to call more
+// than once.
+func (cm *ConversationManager) Stop() {
+	if cm.cancel != nil {
+		cm.cancel()
 	}
 }
 
@@ -48,36 +94,31 @@ func (cm *ConversationManager) SetQuery(query string) {
 	cm.sendUpdate()
 
 	// Start loading animation
+	if cm.wg != nil {
+		cm.wg.Add("conversation-loading-animation")
+	}
 	go cm.startLoadingAnimation()
 
 	// Start timeout timer
+	if cm.wg != nil {
+		cm.wg.Add("conversation-timeout")
+	}
 	go cm.startTimeout()
 }
 
-// startLoadingAnimation animates a loading indicator while processing
+// startLoadingAnimation animates a loading indicator while processing,
+// driven by whichever LoadingAnimation is currently set - re-read every
+// tick so a live SetAnimation swap takes effect immediately.
 func (cm *ConversationManager) startLoadingAnimation() {
-	// Spinner frames using Braille characters for smooth animation
-	spinners := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
-
-	// Loading messages that cycle with spinner
-	loadingStates := []string{
-		"Thinking",
-		"Processing",
-		"Analyzing",
-		"Searching",
+	if cm.wg != nil {
+		defer cm.wg.Done("conversation-loading-animation")
 	}
 
-	loadingMessages := make([]string, 0)
-
-	// Generate combinations with dot animation
-	for _, state := range loadingStates {
-		for i := 0; i <= 3; i++ {
-			dots := strings.Repeat(".", i)
-			loadingMessages = append(loadingMessages, state+dots)
-		}
-	}
+	cm.mu.RLock()
+	anim := cm.animation
+	cm.mu.RUnlock()
 
-	ticker := time.NewTicker(150 * time.Millisecond)
+	ticker := time.NewTicker(anim.Interval())
 	defer ticker.Stop()
 
 	frame := 0
@@ -90,18 +131,17 @@ func (cm *ConversationManager) startLoadingAnimation() {
 				return
 			}
 			cm.data.LoadingFrame = frame
-
-			// Combine spinner with message
-			spinnerIdx := frame % len(spinners)
-			msgIdx := (frame / len(spinners)) % len(loadingMessages)
-			cm.data.Response = spinners[spinnerIdx] + " " + loadingMessages[msgIdx]
-
+			cm.data.Response = cm.animation.Frame(frame)
 			cm.mu.Unlock()
+
 			cm.sendUpdate()
 			frame++
 
 		case <-cm.stopAnimation:
 			return
+
+		case <-cm.ctx.Done():
+			return
 		}
 	}
 }
@@ -122,15 +162,28 @@ func (cm *ConversationManager) SetResponse(response string) {
 	cm.mu.Unlock()
 
 	// Stream response character by character
+	if cm.wg != nil {
+		cm.wg.Add("conversation-stream")
+	}
 	go cm.streamResponse(response)
 }
 
 // streamResponse streams the response with typewriter effect
 func (cm *ConversationManager) streamResponse(fullResponse string) {
+	if cm.wg != nil {
+		defer cm.wg.Done("conversation-stream")
+	}
+
 	// Type at ~100 characters per second (10ms per char)
 	charDelay := 10 * time.Millisecond
 
 	for i := range fullResponse {
+		select {
+		case <-cm.ctx.Done():
+			return
+		default:
+		}
+
 		cm.mu.Lock()
 		cm.data.Response = fullResponse[:i+1]
 		cm.mu.Unlock()
@@ -148,6 +201,25 @@ func (cm *ConversationManager) streamResponse(fullResponse string) {
 	cm.sendUpdate()
 }
 
+// ScrollBy adjusts ScrollOffset by delta lines (positive scrolls back into
+// history, negative scrolls toward the latest message), clamped to
+// [0, maxOffset]. maxOffset is the number of lines the panel rendering
+// this data currently has available to scroll through.
+func (cm *ConversationManager) ScrollBy(delta, maxOffset int) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.data.ScrollOffset += delta
+	if cm.data.ScrollOffset < 0 {
+		cm.data.ScrollOffset = 0
+	}
+	if cm.data.ScrollOffset > maxOffset {
+		cm.data.ScrollOffset = maxOffset
+	}
+
+	cm.sendUpdate()
+}
+
 // Clear clears the conversation
 func (cm *ConversationManager) Clear() {
 	// Stop any running animations
@@ -169,7 +241,15 @@ func (cm *ConversationManager) Clear() {
 
 // startTimeout sets a timeout for the current query
 func (cm *ConversationManager) startTimeout() {
-	time.Sleep(cm.timeout)
+	if cm.wg != nil {
+		defer cm.wg.Done("conversation-timeout")
+	}
+
+	select {
+	case <-time.After(cm.timeout):
+	case <-cm.ctx.Done():
+		return
+	}
 
 	// Stop any running animations
 	select {
@@ -0,0 +1,427 @@
+package managers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
+)
+
+// newsQueryPayload is the typed Request payload Fetch sends, replacing the
+// English "What's the news?" string the legacy server expects.
+type newsQueryPayload struct {
+	Query string `json:"query"`
+}
+
+// NewsResponse is the typed payload an Envelope-aware server replies with
+// for a "news.query" Request, letting Fetch skip parseNewsResponse's
+// regex-ish heuristics entirely when it's available.
+type NewsResponse struct {
+	Headlines []string `json:"headlines"`
+}
+
+// NewsData represents news information
+type NewsData struct {
+	Headlines    []string
+	CurrentIndex int
+	LastUpdated  string
+	Loading      bool
+	Stale        bool
+}
+
+// NewsManager manages news data updates
+type NewsManager struct {
+	client   *client.Client
+	ticker   *time.Ticker
+	interval time.Duration
+	maxAge   time.Duration
+
+	data     NewsData
+	mu       sync.RWMutex
+	paused   bool
+	cachedAt time.Time
+
+	updates chan NewsData
+	wg      *shutdown.WaitGroup
+	cancel  context.CancelFunc
+}
+
+// NewNewsManager creates a new NewsManager. maxAge suppresses Start's
+// initial Fetch() when the disk cache is still younger than it; pass 0 to
+// always fetch immediately.
+func NewNewsManager(c *client.Client, interval time.Duration, maxAge time.Duration) *NewsManager {
+	nm := &NewsManager{
+		client:   c,
+		interval: interval,
+		maxAge:   maxAge,
+		data: NewsData{
+			Headlines: []string{"Loading news..."},
+			Loading:   true,
+		},
+		updates: make(chan NewsData, 1),
+	}
+
+	if cached, fetchedAt, ok := loadNewsCache(); ok {
+		cached.Loading = false
+		cached.Stale = time.Since(fetchedAt) > interval
+		nm.data = cached
+		nm.cachedAt = fetchedAt
+	}
+
+	return nm
+}
+
+// Start begins the news update loop. The loop, and each in-flight Fetch()
+// it triggers, registers with wg so a shutdown can wait for them to finish
+// instead of dropping a query mid-flight.
+func (nm *NewsManager) Start(ctx context.Context, wg *shutdown.WaitGroup) {
+	ctx, cancel := context.WithCancel(ctx)
+	nm.cancel = cancel
+	nm.wg = wg
+	nm.ticker = time.NewTicker(nm.interval)
+
+	// Subscribe instead of relying on App.handleMessage to pattern-sniff
+	// the query text of every incoming message.
+	nm.client.Subscribe("news.headlines", func(msg *protocol.Message) {
+		nm.ProcessResponse(msg.Response)
+	})
+
+	// Serve the cached reading immediately so the dashboard has content
+	// before the first server round-trip.
+	nm.sendUpdate()
+
+	// Skip the immediate fetch if the cache is still within maxAge - no
+	// point re-querying the server for data we already trust.
+	if nm.maxAge <= 0 || time.Since(nm.cachedAt) > nm.maxAge {
+		nm.fetchAsync()
+	}
+
+	wg.Add("news-manager")
+	go func() {
+		defer wg.Done("news-manager")
+
+		for {
+			select {
+			case <-nm.ticker.C:
+				// Only fetch if not paused
+				nm.mu.RLock()
+				isPaused := nm.paused
+				nm.mu.RUnlock()
+				if !isPaused {
+					nm.fetchAsync()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// fetchAsync runs Fetch on its own goroutine, registering with wg before
+// spawning it rather than letting Fetch register itself once already
+// running - otherwise a shutdown's Wait could return between the go
+// statement and the goroutine reaching its own wg.Add, dropping this fetch
+// instead of waiting for it.
+func (nm *NewsManager) fetchAsync() {
+	if nm.wg != nil {
+		nm.wg.Add("news-fetch")
+		go func() {
+			defer nm.wg.Done("news-fetch")
+			nm.doFetch()
+		}()
+		return
+	}
+	go nm.doFetch()
+}
+
+// Fetch requests news data from the server. It registers with wg itself, so
+// it's also safe to call directly (as eventLoop does) rather than only via
+// fetchAsync.
+func (nm *NewsManager) Fetch() {
+	if nm.wg != nil {
+		nm.wg.Add("news-fetch")
+		defer nm.wg.Done("news-fetch")
+	}
+	nm.doFetch()
+}
+
+// doFetch does the actual news request. Callers are responsible for any wg
+// registration - see Fetch and fetchAsync.
+func (nm *NewsManager) doFetch() {
+	// Check if client is connected
+	if !nm.client.IsConnected() {
+		return
+	}
+
+	// Set loading state
+	nm.mu.Lock()
+	nm.data.Loading = true
+	nm.mu.Unlock()
+	nm.sendUpdate()
+
+	if nm.fetchTyped() {
+		return
+	}
+
+	// Fall back to the legacy fire-and-forget query for a server that
+	// doesn't speak the Envelope/Request protocol - its plain-text reply
+	// still arrives through the "news.headlines" Subscribe registered in
+	// Start, and ProcessResponse parses it the old way.
+	if err := nm.client.Send("What's the news?"); err != nil {
+		nm.mu.Lock()
+		nm.data.Loading = false
+		nm.mu.Unlock()
+		nm.sendUpdate()
+	}
+}
+
+// fetchTyped tries the typed Request/Envelope protocol, applying the
+// response and returning true on success. The timeout is short and
+// independent of the legacy query's own round-trip: a server that doesn't
+// understand "news.query" at all will never reply, so every Fetch would
+// otherwise stall before falling back.
+func (nm *NewsManager) fetchTyped() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	env, err := nm.client.Request(ctx, "news.query", newsQueryPayload{Query: "news"})
+	if err != nil {
+		return false
+	}
+
+	var resp NewsResponse
+	if err := json.Unmarshal(env.Payload, &resp); err != nil {
+		return false
+	}
+
+	nm.applyHeadlines(resp.Headlines)
+	return true
+}
+
+// ProcessResponse parses a plain-text news response from the legacy server
+// format. Prefer a typed NewsResponse (see fetchTyped) when the server
+// supports it - this regex-based parser only exists because the real PACE
+// server doesn't yet reply with one.
+func (nm *NewsManager) ProcessResponse(response string) {
+	nm.applyHeadlines(parseNewsResponse(response))
+}
+
+// applyHeadlines stores headlines as the current news data, caches it to
+// disk, and pushes an update. Shared by both the typed and legacy reply
+// paths once each has reduced its response to a plain []string.
+func (nm *NewsManager) applyHeadlines(headlines []string) {
+	now := time.Now()
+
+	nm.mu.Lock()
+	nm.data.Headlines = headlines
+	nm.data.CurrentIndex = 0
+	nm.data.LastUpdated = now.Format("3:04 PM")
+	nm.data.Loading = false
+	nm.data.Stale = false
+	nm.cachedAt = now
+	data := nm.data
+	nm.mu.Unlock()
+
+	if err := saveNewsCache(data, now); err != nil {
+		logging.Get("news").Warn("failed to save news cache", "error", err)
+	}
+
+	// Send update after releasing lock to avoid deadlock
+	select {
+	case nm.updates <- data:
+	default:
+	}
+}
+
+// parseNewsResponse extracts headlines from the response
+func parseNewsResponse(response string) []string {
+	headlines := []string{}
+
+	// Look for common patterns
+	// Pattern 1: "Here are the latest headlines: [content]"
+	if strings.Contains(strings.ToLower(response), "headlines") {
+		// Find the content after "headlines:"
+		parts := strings.Split(response, ":")
+		if len(parts) > 1 {
+			content := parts[1]
+			// Split by periods or newlines
+			lines := strings.FieldsFunc(content, func(r rune) bool {
+				return r == '.' || r == '\n'
+			})
+
+			for _, line := range lines {
+				line = strings.TrimSpace(line)
+				// Remove common prefixes
+				line = strings.TrimPrefix(line, "- ")
+				line = strings.TrimPrefix(line, "â€¢ ")
+				line = strings.TrimPrefix(line, "* ")
+
+				// Remove numbering (1., 2., etc.)
+				numberRegex := strings.TrimPrefix(line, "1")
+				numberRegex = strings.TrimPrefix(numberRegex, "2")
+				numberRegex = strings.TrimPrefix(numberRegex, "3")
+				numberRegex = strings.TrimPrefix(numberRegex, "4")
+				numberRegex = strings.TrimPrefix(numberRegex, "5")
+				line = strings.TrimPrefix(numberRegex, ". ")
+
+				if line != "" && len(line) > 10 {
+					headlines = append(headlines, line)
+					if len(headlines) >= 5 {
+						break
+					}
+				}
+			}
+		}
+	}
+
+	// Fallback: split by sentence
+	if len(headlines) == 0 {
+		sentences := strings.Split(response, ". ")
+		for _, sentence := range sentences {
+			sentence = strings.TrimSpace(sentence)
+			if sentence != "" && len(sentence) > 20 {
+				headlines = append(headlines, sentence)
+				if len(headlines) >= 5 {
+					break
+				}
+			}
+		}
+	}
+
+	if len(headlines) == 0 {
+		headlines = []string{"No news available"}
+	}
+
+	return headlines
+}
+
+// Next moves to the next headline
+func (nm *NewsManager) Next() {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if len(nm.data.Headlines) == 0 {
+		return
+	}
+
+	nm.data.CurrentIndex = (nm.data.CurrentIndex + 1) % len(nm.data.Headlines)
+	nm.sendUpdate()
+}
+
+// Previous moves to the previous headline
+func (nm *NewsManager) Previous() {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	if len(nm.data.Headlines) == 0 {
+		return
+	}
+
+	nm.data.CurrentIndex--
+	if nm.data.CurrentIndex < 0 {
+		nm.data.CurrentIndex = len(nm.data.Headlines) - 1
+	}
+	nm.sendUpdate()
+}
+
+// SetCurrentIndex jumps directly to the given headline index, e.g. in
+// response to a mouse click on that headline. Out-of-range indices are
+// ignored.
+func (nm *NewsManager) SetCurrentIndex(i int) {
+	nm.mu.Lock()
+	if i < 0 || i >= len(nm.data.Headlines) {
+		nm.mu.Unlock()
+		return
+	}
+	nm.data.CurrentIndex = i
+	data := nm.data
+	nm.mu.Unlock()
+
+	select {
+	case nm.updates <- data:
+	default:
+	}
+}
+
+// sendUpdate sends current news data to the updates channel
+func (nm *NewsManager) sendUpdate() {
+	nm.mu.RLock()
+	data := nm.data
+	nm.mu.RUnlock()
+
+	select {
+	case nm.updates <- data:
+	default:
+	}
+}
+
+// Updates returns the channel for receiving news updates
+func (nm *NewsManager) Updates() <-chan NewsData {
+	return nm.updates
+}
+
+// GetData returns the current news data
+func (nm *NewsManager) GetData() NewsData {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+	return nm.data
+}
+
+// GetCurrentIndex returns the current headline index
+func (nm *NewsManager) GetCurrentIndex() int {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+	return nm.data.CurrentIndex
+}
+
+// GetHeadlineCount returns the number of headlines
+func (nm *NewsManager) GetHeadlineCount() int {
+	nm.mu.RLock()
+	defer nm.mu.RUnlock()
+	return len(nm.data.Headlines)
+}
+
+// SetInterval changes the fetch interval, taking effect immediately via
+// ticker.Reset rather than waiting for Start to be called again. Safe to
+// call before Start; the new interval just takes effect once Start creates
+// the ticker.
+func (nm *NewsManager) SetInterval(d time.Duration) {
+	nm.mu.Lock()
+	nm.interval = d
+	ticker := nm.ticker
+	nm.mu.Unlock()
+
+	if ticker != nil {
+		ticker.Reset(d)
+	}
+}
+
+// Pause pauses automatic news updates
+func (nm *NewsManager) Pause() {
+	nm.mu.Lock()
+	nm.paused = true
+	nm.mu.Unlock()
+}
+
+// Resume resumes automatic news updates
+func (nm *NewsManager) Resume() {
+	nm.mu.Lock()
+	nm.paused = false
+	nm.mu.Unlock()
+}
+
+// Stop stops the news manager. It's safe to call more than once.
+func (nm *NewsManager) Stop() {
+	if nm.ticker != nil {
+		nm.ticker.Stop()
+	}
+	if nm.cancel != nil {
+		nm.cancel()
+	}
+}
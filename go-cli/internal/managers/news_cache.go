@@ -0,0 +1,50 @@
+package managers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// newsCacheEntry is the on-disk shape of the news cache: the last
+// successfully parsed NewsData plus when it was fetched, so a fresh process
+// can tell how stale it is before the server answers.
+type newsCacheEntry struct {
+	Data      NewsData  `json:"data"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+func newsCachePath() string {
+	return filepath.Join(cacheDir(), "news.json")
+}
+
+// loadNewsCache returns the last persisted NewsData and when it was
+// fetched. ok is false if there's no cache yet or it can't be read.
+func loadNewsCache() (data NewsData, fetchedAt time.Time, ok bool) {
+	raw, err := os.ReadFile(newsCachePath())
+	if err != nil {
+		return NewsData{}, time.Time{}, false
+	}
+
+	var entry newsCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return NewsData{}, time.Time{}, false
+	}
+
+	return entry.Data, entry.FetchedAt, true
+}
+
+// saveNewsCache persists data as the most recent successful fetch.
+func saveNewsCache(data NewsData, fetchedAt time.Time) error {
+	raw, err := json.Marshal(newsCacheEntry{Data: data, FetchedAt: fetchedAt})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cacheDir(), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(newsCachePath(), raw, 0644)
+}
@@ -1,7 +1,11 @@
 package managers
 
 import (
+	"context"
+	"sync"
 	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
 )
 
 // TimeData represents current time information
@@ -14,8 +18,12 @@ type TimeData struct {
 type TimeManager struct {
 	ticker   *time.Ticker
 	interval time.Duration
-	updates  chan TimeData
-	stop     chan bool
+
+	data TimeData
+	mu   sync.RWMutex
+
+	updates chan TimeData
+	cancel  context.CancelFunc
 }
 
 // NewTimeManager creates a new TimeManager
@@ -23,15 +31,22 @@ func NewTimeManager(interval time.Duration) *TimeManager {
 	return &TimeManager{
 		interval: interval,
 		updates:  make(chan TimeData, 1),
-		stop:     make(chan bool),
 	}
 }
 
-// Start begins the time update loop
-func (tm *TimeManager) Start() {
+// Start begins the time update loop. The loop registers itself with wg as
+// "time-manager" and exits once ctx is cancelled (by Stop, or a parent
+// shutdown) rather than relying on a stop channel that panics if closed
+// twice.
+func (tm *TimeManager) Start(ctx context.Context, wg *shutdown.WaitGroup) {
+	ctx, cancel := context.WithCancel(ctx)
+	tm.cancel = cancel
 	tm.ticker = time.NewTicker(tm.interval)
 
+	wg.Add("time-manager")
 	go func() {
+		defer wg.Done("time-manager")
+
 		// Send initial update
 		tm.sendUpdate()
 
@@ -39,7 +54,7 @@ func (tm *TimeManager) Start() {
 			select {
 			case <-tm.ticker.C:
 				tm.sendUpdate()
-			case <-tm.stop:
+			case <-ctx.Done():
 				return
 			}
 		}
@@ -55,6 +70,10 @@ func (tm *TimeManager) sendUpdate() {
 		Date: now.Format("Mon, Jan 2, 2006"),
 	}
 
+	tm.mu.Lock()
+	tm.data = data
+	tm.mu.Unlock()
+
 	// Non-blocking send
 	select {
 	case tm.updates <- data:
@@ -68,10 +87,19 @@ func (tm *TimeManager) Updates() <-chan TimeData {
 	return tm.updates
 }
 
-// Stop stops the time manager
+// GetData returns the most recently computed time data
+func (tm *TimeManager) GetData() TimeData {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	return tm.data
+}
+
+// Stop stops the time manager. It's safe to call more than once.
 func (tm *TimeManager) Stop() {
 	if tm.ticker != nil {
 		tm.ticker.Stop()
 	}
-	close(tm.stop)
+	if tm.cancel != nil {
+		tm.cancel()
+	}
 }
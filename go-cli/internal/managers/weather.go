@@ -1,22 +1,30 @@
 package managers
 
 import (
-	"regexp"
-	"strings"
+	"context"
 	"sync"
 	"time"
 
 	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
+	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
 )
 
-// WeatherData represents weather information
+// WeatherData represents weather information. Which fields get populated
+// depends on the configured WeatherProvider - RenderWeather skips anything
+// left empty.
 type WeatherData struct {
 	City        string
 	Temperature string
 	Description string
 	FeelsLike   string
+	Humidity    string
+	WindMPH     string
+	Forecast    []DayForecast
 	LastUpdated string
 	Loading     bool
+	Stale       bool
 }
 
 // WeatherManager manages weather data updates
@@ -24,36 +32,75 @@ type WeatherManager struct {
 	client   *client.Client
 	ticker   *time.Ticker
 	interval time.Duration
+	maxAge   time.Duration
 
-	data   WeatherData
-	mu     sync.RWMutex
-	paused bool
+	data     WeatherData
+	mu       sync.RWMutex
+	paused   bool
+	cachedAt time.Time
 
-	updates chan WeatherData
-	stop    chan bool
+	updates  chan WeatherData
+	wg       *shutdown.WaitGroup
+	cancel   context.CancelFunc
+	provider WeatherProvider
 }
 
-// NewWeatherManager creates a new WeatherManager
-func NewWeatherManager(c *client.Client, interval time.Duration) *WeatherManager {
-	return &WeatherManager{
+// NewWeatherManager creates a new WeatherManager. provider selects how
+// ProcessResponse parses the server's weather replies; pass
+// LegacyTextProvider{} for the PACE server's natural-language format.
+// maxAge suppresses Start's initial Fetch() when the disk cache is still
+// younger than it; pass 0 to always fetch immediately.
+func NewWeatherManager(c *client.Client, interval time.Duration, provider WeatherProvider, maxAge time.Duration) *WeatherManager {
+	wm := &WeatherManager{
 		client:   c,
 		interval: interval,
+		maxAge:   maxAge,
 		data: WeatherData{
 			Loading: true,
 		},
-		updates: make(chan WeatherData, 1),
-		stop:    make(chan bool),
+		updates:  make(chan WeatherData, 1),
+		provider: provider,
 	}
+
+	if cached, fetchedAt, ok := loadWeatherCache(); ok {
+		cached.Loading = false
+		cached.Stale = time.Since(fetchedAt) > interval
+		wm.data = cached
+		wm.cachedAt = fetchedAt
+	}
+
+	return wm
 }
 
-// Start begins the weather update loop
-func (wm *WeatherManager) Start() {
+// Start begins the weather update loop. The loop, and each in-flight
+// Fetch() it triggers, registers with wg so a shutdown can wait for them to
+// finish instead of dropping a query mid-flight.
+func (wm *WeatherManager) Start(ctx context.Context, wg *shutdown.WaitGroup) {
+	ctx, cancel := context.WithCancel(ctx)
+	wm.cancel = cancel
+	wm.wg = wg
 	wm.ticker = time.NewTicker(wm.interval)
 
-	// Fetch immediately
-	go wm.Fetch()
+	// Subscribe instead of relying on App.handleMessage to pattern-sniff
+	// the query text of every incoming message.
+	wm.client.Subscribe("weather.current", func(msg *protocol.Message) {
+		wm.ProcessResponse(msg.Response)
+	})
+
+	// Serve the cached reading immediately so the dashboard has content
+	// before the first server round-trip.
+	wm.sendUpdate()
+
+	// Skip the immediate fetch if the cache is still within maxAge - no
+	// point re-querying the server for data we already trust.
+	if wm.maxAge <= 0 || time.Since(wm.cachedAt) > wm.maxAge {
+		wm.fetchAsync()
+	}
 
+	wg.Add("weather-manager")
 	go func() {
+		defer wg.Done("weather-manager")
+
 		for {
 			select {
 			case <-wm.ticker.C:
@@ -62,17 +109,46 @@ func (wm *WeatherManager) Start() {
 				isPaused := wm.paused
 				wm.mu.RUnlock()
 				if !isPaused {
-					go wm.Fetch()
+					wm.fetchAsync()
 				}
-			case <-wm.stop:
+			case <-ctx.Done():
 				return
 			}
 		}
 	}()
 }
 
-// Fetch requests weather data from the server
+// fetchAsync runs Fetch on its own goroutine, registering with wg before
+// spawning it rather than letting Fetch register itself once already
+// running - otherwise a shutdown's Wait could return between the go
+// statement and the goroutine reaching its own wg.Add, dropping this fetch
+// instead of waiting for it.
+func (wm *WeatherManager) fetchAsync() {
+	if wm.wg != nil {
+		wm.wg.Add("weather-fetch")
+		go func() {
+			defer wm.wg.Done("weather-fetch")
+			wm.doFetch()
+		}()
+		return
+	}
+	go wm.doFetch()
+}
+
+// Fetch requests weather data from the server. It registers with wg itself,
+// so it's also safe to call directly (as eventLoop does) rather than only
+// via fetchAsync.
 func (wm *WeatherManager) Fetch() {
+	if wm.wg != nil {
+		wm.wg.Add("weather-fetch")
+		defer wm.wg.Done("weather-fetch")
+	}
+	wm.doFetch()
+}
+
+// doFetch does the actual weather request. Callers are responsible for any
+// wg registration - see Fetch and fetchAsync.
+func (wm *WeatherManager) doFetch() {
 	// Check if client is connected
 	if !wm.client.IsConnected() {
 		return
@@ -94,15 +170,29 @@ func (wm *WeatherManager) Fetch() {
 	}
 }
 
-// ProcessResponse parses a weather response from the server
+// ProcessResponse parses a weather response from the server through the
+// manager's configured WeatherProvider.
 func (wm *WeatherManager) ProcessResponse(response string) {
+	provider := wm.provider
+	if provider == nil {
+		provider = LegacyTextProvider{}
+	}
+
+	now := time.Now()
+
 	wm.mu.Lock()
-	wm.data = parseWeatherResponse(response)
-	wm.data.LastUpdated = time.Now().Format("3:04 PM")
+	wm.data = provider.Parse(response)
+	wm.data.LastUpdated = now.Format("3:04 PM")
 	wm.data.Loading = false
+	wm.data.Stale = false
+	wm.cachedAt = now
 	data := wm.data
 	wm.mu.Unlock()
 
+	if err := saveWeatherCache(data, now); err != nil {
+		logging.Get("weather").Warn("failed to save weather cache", "error", err)
+	}
+
 	// Send update after releasing lock to avoid deadlock
 	select {
 	case wm.updates <- data:
@@ -110,41 +200,6 @@ func (wm *WeatherManager) ProcessResponse(response string) {
 	}
 }
 
-// parseWeatherResponse extracts weather data from the response
-func parseWeatherResponse(response string) WeatherData {
-	data := WeatherData{}
-
-	// Extract temperature (e.g., "72°F", "72 degrees")
-	tempRegex := regexp.MustCompile(`(\d+)°?F`)
-	if matches := tempRegex.FindStringSubmatch(response); len(matches) > 1 {
-		data.Temperature = matches[1] + "°F"
-	}
-
-	// Extract "feels like" temperature
-	feelsLikeRegex := regexp.MustCompile(`feels like (\d+)°?F`)
-	if matches := feelsLikeRegex.FindStringSubmatch(strings.ToLower(response)); len(matches) > 1 {
-		data.FeelsLike = matches[1] + "°F"
-	}
-
-	// Extract city name (typically before a comma or "is")
-	cityRegex := regexp.MustCompile(`(?:in |weather in )([A-Z][a-zA-Z\s]+?)(?:,|\.)`)
-	if matches := cityRegex.FindStringSubmatch(response); len(matches) > 1 {
-		data.City = strings.TrimSpace(matches[1])
-	}
-
-	// Extract weather description (sunny, cloudy, rainy, etc.)
-	conditions := []string{"sunny", "cloudy", "rainy", "snowy", "clear", "overcast", "partly cloudy", "stormy"}
-	responseLower := strings.ToLower(response)
-	for _, condition := range conditions {
-		if strings.Contains(responseLower, condition) {
-			data.Description = strings.Title(condition)
-			break
-		}
-	}
-
-	return data
-}
-
 // sendUpdate sends current weather data to the updates channel
 func (wm *WeatherManager) sendUpdate() {
 	wm.mu.RLock()
@@ -169,6 +224,21 @@ func (wm *WeatherManager) GetData() WeatherData {
 	return wm.data
 }
 
+// SetInterval changes the fetch interval, taking effect immediately via
+// ticker.Reset rather than waiting for Start to be called again. Safe to
+// call before Start; the new interval just takes effect once Start creates
+// the ticker.
+func (wm *WeatherManager) SetInterval(d time.Duration) {
+	wm.mu.Lock()
+	wm.interval = d
+	ticker := wm.ticker
+	wm.mu.Unlock()
+
+	if ticker != nil {
+		ticker.Reset(d)
+	}
+}
+
 // Pause pauses automatic weather updates
 func (wm *WeatherManager) Pause() {
 	wm.mu.Lock()
@@ -183,10 +253,12 @@ func (wm *WeatherManager) Resume() {
 	wm.mu.Unlock()
 }
 
-// Stop stops the weather manager
+// Stop stops the weather manager. It's safe to call more than once.
 func (wm *WeatherManager) Stop() {
 	if wm.ticker != nil {
 		wm.ticker.Stop()
 	}
-	close(wm.stop)
+	if wm.cancel != nil {
+		wm.cancel()
+	}
 }
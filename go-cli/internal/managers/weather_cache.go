@@ -0,0 +1,50 @@
+package managers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// weatherCacheEntry is the on-disk shape of the weather cache: the last
+// successfully parsed WeatherData plus when it was fetched, so a fresh
+// process can tell how stale it is before the server answers.
+type weatherCacheEntry struct {
+	Data      WeatherData `json:"data"`
+	FetchedAt time.Time   `json:"fetched_at"`
+}
+
+func weatherCachePath() string {
+	return filepath.Join(cacheDir(), "weather.json")
+}
+
+// loadWeatherCache returns the last persisted WeatherData and when it was
+// fetched. ok is false if there's no cache yet or it can't be read.
+func loadWeatherCache() (data WeatherData, fetchedAt time.Time, ok bool) {
+	raw, err := os.ReadFile(weatherCachePath())
+	if err != nil {
+		return WeatherData{}, time.Time{}, false
+	}
+
+	var entry weatherCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return WeatherData{}, time.Time{}, false
+	}
+
+	return entry.Data, entry.FetchedAt, true
+}
+
+// saveWeatherCache persists data as the most recent successful fetch.
+func saveWeatherCache(data WeatherData, fetchedAt time.Time) error {
+	raw, err := json.Marshal(weatherCacheEntry{Data: data, FetchedAt: fetchedAt})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cacheDir(), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(weatherCachePath(), raw, 0644)
+}
@@ -0,0 +1,152 @@
+package managers
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DayForecast is one day of a multi-day forecast.
+type DayForecast struct {
+	Day       string
+	High      string
+	Low       string
+	Condition string
+}
+
+// WeatherProvider parses a server Response string into WeatherData. Fields a
+// provider can't populate from its source are left at their zero value;
+// RenderWeather skips anything empty.
+type WeatherProvider interface {
+	Parse(response string) WeatherData
+}
+
+// LegacyTextProvider parses the PACE server's natural-language weather
+// replies (e.g. "72°F, feels like 70°F, partly cloudy in Austin"). It's the
+// default for backward compat with servers that don't speak the JSON format.
+type LegacyTextProvider struct{}
+
+// Parse implements WeatherProvider.
+func (LegacyTextProvider) Parse(response string) WeatherData {
+	return parseWeatherResponseText(response)
+}
+
+// jsonWeatherResponse is the shape JSONProvider expects in Response: an
+// OpenWeatherMap-style document rather than a sentence to scan with regexes.
+type jsonWeatherResponse struct {
+	City       string            `json:"city"`
+	TempF      float64           `json:"temp_f"`
+	FeelsLikeF float64           `json:"feels_like_f"`
+	Humidity   int               `json:"humidity"`
+	WindMPH    float64           `json:"wind_mph"`
+	Condition  string            `json:"condition"`
+	Forecast   []jsonDayForecast `json:"forecast"`
+}
+
+type jsonDayForecast struct {
+	Day       string  `json:"day"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Condition string  `json:"condition"`
+}
+
+// JSONProvider parses a structured JSON weather document, giving access to
+// humidity, wind, and forecast data the legacy text format can't express.
+type JSONProvider struct{}
+
+// Parse implements WeatherProvider. A malformed or non-JSON response yields
+// a mostly-empty WeatherData rather than an error, matching
+// LegacyTextProvider's best-effort behavior.
+func (JSONProvider) Parse(response string) WeatherData {
+	var doc jsonWeatherResponse
+	if err := json.Unmarshal([]byte(response), &doc); err != nil {
+		return WeatherData{}
+	}
+
+	data := WeatherData{
+		City:        doc.City,
+		Humidity:    formatPercent(doc.Humidity),
+		WindMPH:     formatMPH(doc.WindMPH),
+		Description: strings.Title(doc.Condition),
+	}
+	if doc.TempF != 0 {
+		data.Temperature = formatF(doc.TempF)
+	}
+	if doc.FeelsLikeF != 0 {
+		data.FeelsLike = formatF(doc.FeelsLikeF)
+	}
+	for _, d := range doc.Forecast {
+		data.Forecast = append(data.Forecast, DayForecast{
+			Day:       d.Day,
+			High:      formatF(d.High),
+			Low:       formatF(d.Low),
+			Condition: strings.Title(d.Condition),
+		})
+	}
+
+	return data
+}
+
+func formatF(v float64) string {
+	return fmt.Sprintf("%.0f°F", v)
+}
+
+func formatMPH(v float64) string {
+	return fmt.Sprintf("%.0f mph", v)
+}
+
+func formatPercent(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", v)
+}
+
+// parseWeatherResponseText is the original regex-based parser, kept under a
+// new name now that it's one of two providers rather than the only option.
+func parseWeatherResponseText(response string) WeatherData {
+	data := WeatherData{}
+
+	// Extract temperature (e.g., "72°F", "72 degrees")
+	tempRegex := regexp.MustCompile(`(\d+)°?F`)
+	if matches := tempRegex.FindStringSubmatch(response); len(matches) > 1 {
+		data.Temperature = matches[1] + "°F"
+	}
+
+	// Extract "feels like" temperature
+	feelsLikeRegex := regexp.MustCompile(`feels like (\d+)°?F`)
+	if matches := feelsLikeRegex.FindStringSubmatch(strings.ToLower(response)); len(matches) > 1 {
+		data.FeelsLike = matches[1] + "°F"
+	}
+
+	// Extract city name (typically before a comma or "is")
+	cityRegex := regexp.MustCompile(`(?:in |weather in )([A-Z][a-zA-Z\s]+?)(?:,|\.)`)
+	if matches := cityRegex.FindStringSubmatch(response); len(matches) > 1 {
+		data.City = strings.TrimSpace(matches[1])
+	}
+
+	// Extract weather description (sunny, cloudy, rainy, etc.)
+	conditions := []string{"sunny", "cloudy", "rainy", "snowy", "clear", "overcast", "partly cloudy", "stormy"}
+	responseLower := strings.ToLower(response)
+	for _, condition := range conditions {
+		if strings.Contains(responseLower, condition) {
+			data.Description = strings.Title(condition)
+			break
+		}
+	}
+
+	return data
+}
+
+// ProviderForFormat resolves a weather_format config value ("text" or
+// "json") to a WeatherProvider, defaulting to LegacyTextProvider for "" or
+// any unrecognized value.
+func ProviderForFormat(format string) WeatherProvider {
+	switch format {
+	case "json":
+		return JSONProvider{}
+	default:
+		return LegacyTextProvider{}
+	}
+}
@@ -0,0 +1,66 @@
+// Package shutdown provides a named WaitGroup so a bounded shutdown can log
+// exactly which goroutines are still outstanding instead of hanging silently
+// or panicking on a double close.
+package shutdown
+
+import (
+	"sync"
+	"time"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+)
+
+// WaitGroup wraps sync.WaitGroup, tracking outstanding goroutines by name.
+type WaitGroup struct {
+	wg sync.WaitGroup
+
+	mu      sync.Mutex
+	pending map[string]int
+}
+
+// NewWaitGroup creates an empty WaitGroup.
+func NewWaitGroup() *WaitGroup {
+	return &WaitGroup{pending: make(map[string]int)}
+}
+
+// Add registers one more outstanding goroutine under name.
+func (w *WaitGroup) Add(name string) {
+	w.mu.Lock()
+	w.pending[name]++
+	w.mu.Unlock()
+	w.wg.Add(1)
+}
+
+// Done marks one goroutine registered under name as finished.
+func (w *WaitGroup) Done(name string) {
+	w.mu.Lock()
+	if w.pending[name] > 0 {
+		w.pending[name]--
+	}
+	if w.pending[name] == 0 {
+		delete(w.pending, name)
+	}
+	w.mu.Unlock()
+	w.wg.Done()
+}
+
+// Wait blocks until every registered goroutine has called Done, or until
+// timeout elapses - whichever comes first. If the timeout fires, it logs
+// the names of whatever is still outstanding before returning.
+func (w *WaitGroup) Wait(timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		for name, count := range w.pending {
+			logging.Get("shutdown").Warn("goroutine still outstanding", "name", name, "timeout", timeout, "count", count)
+		}
+	}
+}
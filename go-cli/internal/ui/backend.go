@@ -0,0 +1,110 @@
+package ui
+
+// Event is something a Backend can deliver from PollEvent: a key press, a
+// mouse action, a terminal resize, or a bracketed paste. Concrete types are
+// EventKey, EventMouse, EventResize, and EventPaste.
+type Event interface {
+	isEvent()
+}
+
+// EventKey is a single key press. Rune is set for printable keys; Bytes
+// holds the raw bytes read for the key (useful for control sequences that
+// don't map to a single rune, e.g. arrow keys).
+type EventKey struct {
+	Rune  rune
+	Bytes []byte
+}
+
+func (EventKey) isEvent() {}
+
+// MouseButton identifies which mouse button or wheel direction produced an
+// EventMouse.
+type MouseButton int
+
+const (
+	MouseNone MouseButton = iota
+	MouseLeft
+	MouseRight
+	MouseMiddle
+	MouseWheelUp
+	MouseWheelDown
+)
+
+// EventMouse is a mouse click or scroll, in 0-indexed screen coordinates.
+type EventMouse struct {
+	X, Y   int
+	Button MouseButton
+	Shift  bool
+}
+
+func (EventMouse) isEvent() {}
+
+// EventResize is delivered when the terminal size changes.
+type EventResize struct {
+	Width, Height int
+}
+
+func (EventResize) isEvent() {}
+
+// EventPaste is delivered for a bracketed paste, with the pasted text
+// already stripped of the bracketing escape sequences.
+type EventPaste struct {
+	Text string
+}
+
+func (EventPaste) isEvent() {}
+
+// Style is a terminal text style. For the ANSI backend this is literally
+// the SGR escape sequence to emit before the text (e.g. theme.Fg, or
+// ColorBold+theme.Fg); backends that don't speak raw ANSI are expected to
+// translate it into their own style representation.
+type Style string
+
+// Backend is the sink every panel renderer writes to, and the source of
+// input events. Rendering used to write ANSI escapes straight to
+// os.Stdout and read raw bytes straight from os.Stdin; routing everything
+// through Backend instead means a different implementation (e.g. one
+// backed by tcell) can take over without panels.go knowing or caring -
+// which is what makes proper Windows console support, mouse tracking, and
+// bracketed paste detection possible, none of which can be done reliably
+// by parsing raw bytes by hand.
+type Backend interface {
+	// Clear erases the screen.
+	Clear()
+	// MoveTo positions subsequent WriteString calls at (x, y), 0-indexed.
+	MoveTo(x, y int)
+	// SetStyle sets the style applied to subsequent WriteString calls,
+	// until the next SetStyle call.
+	SetStyle(s Style)
+	// WriteString writes text at the current cursor position.
+	WriteString(s string)
+	// Flush sends any buffered output to the terminal.
+	Flush()
+	// Size returns the current terminal dimensions.
+	Size() (w, h int)
+	// PollEvent blocks until the next input event is available.
+	PollEvent() Event
+	// Close restores the terminal to its original state.
+	Close() error
+}
+
+// NoopBackend discards everything it's given and never delivers an event.
+// It's useful for headless runs (tests, CI) where there's no real
+// terminal to render to.
+type NoopBackend struct {
+	Width, Height int
+}
+
+// NewNoopBackend creates a NoopBackend reporting the given fixed size.
+func NewNoopBackend(width, height int) *NoopBackend {
+	return &NoopBackend{Width: width, Height: height}
+}
+
+func (b *NoopBackend) Clear()             {}
+func (b *NoopBackend) MoveTo(x, y int)    {}
+func (b *NoopBackend) SetStyle(s Style)   {}
+func (b *NoopBackend) WriteString(s string) {}
+func (b *NoopBackend) Flush()             {}
+func (b *NoopBackend) Size() (int, int)   { return b.Width, b.Height }
+func (b *NoopBackend) PollEvent() Event   { select {} } // never returns
+func (b *NoopBackend) Close() error       { return nil }
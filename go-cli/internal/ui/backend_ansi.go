@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ANSIBackend is the original rendering backend: it writes raw ANSI
+// escape codes straight to os.Stdout and reads raw bytes straight from
+// os.Stdin, the same approach panels.go used before Backend existed.
+type ANSIBackend struct {
+	caps  *TerminalCapabilities
+	buf   strings.Builder
+	style Style
+}
+
+// NewANSIBackend creates an ANSIBackend reporting sizes from caps.
+func NewANSIBackend(caps *TerminalCapabilities) *ANSIBackend {
+	return &ANSIBackend{caps: caps}
+}
+
+// Clear queues a "clear screen, cursor home" sequence.
+func (b *ANSIBackend) Clear() {
+	b.buf.WriteString(ClearScreen + CursorHome)
+}
+
+// MoveTo queues a cursor-position escape code.
+func (b *ANSIBackend) MoveTo(x, y int) {
+	b.buf.WriteString(moveCursor(x, y))
+}
+
+// SetStyle sets the SGR sequence applied to subsequent WriteString calls.
+func (b *ANSIBackend) SetStyle(s Style) {
+	b.style = s
+}
+
+// WriteString queues s, wrapped in the current style (if any) and a
+// trailing reset.
+func (b *ANSIBackend) WriteString(s string) {
+	if b.style != "" {
+		b.buf.WriteString(string(b.style))
+	}
+	b.buf.WriteString(s)
+	if b.style != "" {
+		b.buf.WriteString(ColorReset)
+	}
+}
+
+// Flush writes everything queued since the last Flush to os.Stdout.
+func (b *ANSIBackend) Flush() {
+	fmt.Print(b.buf.String())
+	b.buf.Reset()
+}
+
+// String returns everything queued since the last Flush/String call
+// instead of writing it to os.Stdout, and resets the buffer the same way
+// Flush does. Renderer.Frame uses this to capture a full frame as a plain
+// string rather than painting it immediately.
+func (b *ANSIBackend) String() string {
+	s := b.buf.String()
+	b.buf.Reset()
+	return s
+}
+
+// Size returns the terminal's current dimensions, re-detecting them first.
+func (b *ANSIBackend) Size() (int, int) {
+	b.caps.UpdateSize()
+	return b.caps.Width, b.caps.Height
+}
+
+// PollEvent reads a single byte from os.Stdin and returns it as an
+// EventKey. It does no escape-sequence parsing of its own - the app's
+// main input loop (internal/input.Handler) already owns raw-mode stdin
+// and does that parsing, so nothing in this codebase currently calls
+// ANSIBackend.PollEvent. It's implemented to satisfy the Backend
+// interface for callers (tests, future standalone tools) that want to
+// drive an event loop purely off a Backend.
+func (b *ANSIBackend) PollEvent() Event {
+	buf := make([]byte, 1)
+	n, err := os.Stdin.Read(buf)
+	if err != nil || n == 0 {
+		return nil
+	}
+	return EventKey{Rune: rune(buf[0]), Bytes: buf}
+}
+
+// Close is a no-op: terminal raw-mode setup/teardown is owned by
+// internal/input.Handler, not by the backend.
+func (b *ANSIBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,134 @@
+//go:build tcell
+
+package ui
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// TcellBackend implements Backend on top of a tcell.Screen. Unlike
+// ANSIBackend, tcell owns the terminal directly (via terminfo), which is
+// what gives it working Windows console support, real mouse tracking,
+// and bracketed paste - none of which can be done reliably by parsing
+// raw ANSI bytes by hand. Build with `-tags tcell` once
+// github.com/gdamore/tcell/v2 is vendored; it's not a default dependency
+// of this module.
+type TcellBackend struct {
+	screen tcell.Screen
+	style  tcell.Style
+	x, y   int
+}
+
+// NewTcellBackend initializes a tcell.Screen and enables mouse and paste
+// reporting.
+func NewTcellBackend() (*TcellBackend, error) {
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return nil, err
+	}
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+
+	screen.EnableMouse()
+	screen.EnablePaste()
+
+	return &TcellBackend{screen: screen, style: tcell.StyleDefault}, nil
+}
+
+func (b *TcellBackend) Clear() {
+	b.screen.Clear()
+}
+
+func (b *TcellBackend) MoveTo(x, y int) {
+	b.x, b.y = x, y
+}
+
+func (b *TcellBackend) SetStyle(s Style) {
+	b.style = ansiStyleToTcell(s)
+}
+
+func (b *TcellBackend) WriteString(s string) {
+	for _, r := range s {
+		b.screen.SetContent(b.x, b.y, r, nil, b.style)
+		b.x++
+	}
+}
+
+func (b *TcellBackend) Flush() {
+	b.screen.Show()
+}
+
+func (b *TcellBackend) Size() (int, int) {
+	return b.screen.Size()
+}
+
+func (b *TcellBackend) PollEvent() Event {
+	for {
+		switch ev := b.screen.PollEvent().(type) {
+		case *tcell.EventKey:
+			return EventKey{Rune: ev.Rune()}
+		case *tcell.EventMouse:
+			x, y := ev.Position()
+			button, shift := tcellMouseButton(ev)
+			return EventMouse{X: x, Y: y, Button: button, Shift: shift}
+		case *tcell.EventResize:
+			w, h := ev.Size()
+			return EventResize{Width: w, Height: h}
+		case *tcell.EventPaste:
+			// tcell reports bracketed paste as start/end markers around a
+			// run of EventKey values rather than a single event with the
+			// pasted text; callers that need the whole pasted string are
+			// expected to accumulate EventKey values between EventPaste
+			// start/end themselves.
+			continue
+		default:
+			continue
+		}
+	}
+}
+
+func (b *TcellBackend) Close() error {
+	b.screen.Fini()
+	return nil
+}
+
+// ansiStyleToTcell does a best-effort translation of the ANSI SGR
+// sequences this codebase embeds in Style values (see ColorReset et al in
+// panels.go) into a tcell.Style. It only recognizes the codes this
+// package actually emits.
+func ansiStyleToTcell(s Style) tcell.Style {
+	style := tcell.StyleDefault
+	if s == "" {
+		return style
+	}
+	// Bold is the only attribute panels.go sets independently of color;
+	// actual foreground colors are theme-defined 256-color codes that
+	// tcell can't losslessly recover from an SGR string, so they're left
+	// at the default until the theme package grows a tcell-native
+	// representation.
+	if string(s) == ColorBold {
+		style = style.Bold(true)
+	}
+	return style
+}
+
+// tcellMouseButton maps a tcell mouse event to our MouseButton enum.
+func tcellMouseButton(ev *tcell.EventMouse) (MouseButton, bool) {
+	shift := ev.Modifiers()&tcell.ModShift != 0
+
+	switch {
+	case ev.Buttons()&tcell.Button1 != 0:
+		return MouseLeft, shift
+	case ev.Buttons()&tcell.Button2 != 0:
+		return MouseRight, shift
+	case ev.Buttons()&tcell.Button3 != 0:
+		return MouseMiddle, shift
+	case ev.Buttons()&tcell.WheelUp != 0:
+		return MouseWheelUp, shift
+	case ev.Buttons()&tcell.WheelDown != 0:
+		return MouseWheelDown, shift
+	default:
+		return MouseNone, shift
+	}
+}
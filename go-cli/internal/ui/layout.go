@@ -14,9 +14,11 @@ type Layout struct {
 	ContentStartY int
 	ContentHeight int
 
-	// Conversation panel (left side)
+	// Conversation panel (left side, or top when the active LayoutProfile
+	// is Vertical)
 	ConvStartX int
 	ConvWidth  int
+	ConvHeight int
 
 	// Info panels (right side)
 	InfoStartX int
@@ -33,6 +35,17 @@ type Layout struct {
 	// Input panel (bottom)
 	InputStartY int
 	InputHeight int
+
+	// Tab strip (above the conversation panel; 0 height when only one tab
+	// is open)
+	TabsStartY int
+	TabsHeight int
+
+	// Inline is true when the layout reserves a region below the cursor's
+	// starting row instead of taking over the full screen (e.g.
+	// AdaptiveLayout). OriginY is the absolute row that region starts at.
+	Inline  bool
+	OriginY int
 }
 
 // CalculateLayout calculates panel positions and sizes
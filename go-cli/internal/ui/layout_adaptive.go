@@ -0,0 +1,190 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// AdaptiveLayout implements a layout strategy modeled on fzf's
+// `--height ~N%` option: instead of always taking over the full terminal,
+// it reserves only as many rows as the current content needs - up to a
+// configurable percentage of the terminal height - and renders inline
+// below the cursor's starting position rather than clearing the screen.
+type AdaptiveLayout struct {
+	MaxPercent int
+
+	haveOrigin bool
+	origin     int
+}
+
+// NewAdaptiveLayout creates a new adaptive layout strategy capped at
+// maxPercent of the terminal height (clamped to [10, 100]).
+func NewAdaptiveLayout(maxPercent int) *AdaptiveLayout {
+	if maxPercent <= 0 {
+		maxPercent = DefaultAdaptiveHeightPercent
+	}
+	if maxPercent > 100 {
+		maxPercent = 100
+	}
+	if maxPercent < 10 {
+		maxPercent = 10
+	}
+	return &AdaptiveLayout{MaxPercent: maxPercent}
+}
+
+// Calculate sizes the layout to the content hint, capped at MaxPercent of
+// the terminal height, and starts it at the cursor's current row instead
+// of row 0.
+func (a *AdaptiveLayout) Calculate(width, height int, hint ContentSizeHint) *Layout {
+	maxHeight := height * a.MaxPercent / 100
+	if maxHeight < 6 {
+		maxHeight = 6
+	}
+
+	const headerHeight = 1
+
+	tabsHeight := 0
+	if hint.ShowTabs {
+		tabsHeight = 1
+	}
+
+	inputHeight := inputHeightFor(2, hint)
+
+	infoLines := hint.WeatherLines
+	if hint.NewsLines > infoLines {
+		infoLines = hint.NewsLines
+	}
+
+	contentHeight := headerHeight + tabsHeight + hint.ConversationLines + infoLines + inputHeight
+	if contentHeight > maxHeight {
+		contentHeight = maxHeight
+	}
+	if minHeight := headerHeight + tabsHeight + inputHeight + 3; contentHeight < minHeight {
+		contentHeight = minHeight
+	}
+
+	origin := a.resolveOrigin(maxHeight)
+
+	layout := &Layout{
+		Width:   width,
+		Height:  contentHeight,
+		Inline:  true,
+		OriginY: origin,
+	}
+
+	layout.HeaderStartY = origin
+	layout.HeaderHeight = headerHeight
+
+	layout.TabsStartY = origin + headerHeight
+	layout.TabsHeight = tabsHeight
+
+	layout.InputHeight = inputHeight
+	layout.InputStartY = origin + contentHeight - layout.InputHeight
+
+	layout.ContentStartY = layout.TabsStartY + layout.TabsHeight
+	layout.ContentHeight = layout.InputStartY - layout.ContentStartY
+
+	convPercent, _ := calculateSplitRatio(width)
+	layout.ConvStartX = 0
+	layout.ConvWidth = width * convPercent / 100
+	layout.ConvHeight = layout.ContentHeight
+
+	layout.InfoStartX = layout.ConvWidth
+	layout.InfoWidth = width - layout.ConvWidth
+
+	layout.WeatherStartY = layout.ContentStartY
+	layout.WeatherHeight = layout.ContentHeight * 35 / 100
+
+	layout.NewsStartY = layout.WeatherStartY + layout.WeatherHeight
+	layout.NewsHeight = layout.ContentHeight - layout.WeatherHeight
+
+	return layout
+}
+
+// resolveOrigin reserves maxHeight rows in the terminal's scrollback the
+// first time it's called, then remembers the row that reservation started
+// at so every later frame redraws into the same fixed region instead of
+// drifting down the screen.
+func (a *AdaptiveLayout) resolveOrigin(maxHeight int) int {
+	if a.haveOrigin {
+		return a.origin
+	}
+
+	fmt.Print(strings.Repeat("\n", maxHeight))
+	fmt.Printf("\033[%dA", maxHeight)
+
+	row, err := queryCursorRow()
+	if err != nil {
+		row = 0
+	}
+
+	a.origin = row
+	a.haveOrigin = true
+	return a.origin
+}
+
+// GetMode returns the layout mode
+func (a *AdaptiveLayout) GetMode() LayoutMode {
+	return LayoutModeAdaptive
+}
+
+// ShouldRenderWeather returns true for adaptive mode
+func (a *AdaptiveLayout) ShouldRenderWeather() bool {
+	return true
+}
+
+// ShouldRenderNews returns true for adaptive mode
+func (a *AdaptiveLayout) ShouldRenderNews() bool {
+	return true
+}
+
+// GetHelpText returns help text for the status bar
+func (a *AdaptiveLayout) GetHelpText() string {
+	return "[Ctrl+T: Toggle] [→/←: News] [Ctrl+N/W: Tabs] [Ctrl+Tab: Next] [/help: Commands]"
+}
+
+// queryCursorRow asks the terminal for the cursor's current position via a
+// Device Status Report (DSR) request and parses the "\033[row;colR" reply,
+// returning a 0-based row.
+func queryCursorRow() (int, error) {
+	fd := int(os.Stdin.Fd())
+
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return 0, err
+	}
+	defer term.Restore(fd, oldState)
+
+	os.Stdout.WriteString("\033[6n")
+
+	var reply []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil || n == 0 {
+			return 0, err
+		}
+		reply = append(reply, buf[0])
+		if buf[0] == 'R' {
+			break
+		}
+	}
+
+	row := 0
+	inRow := false
+	for _, b := range reply {
+		switch {
+		case b == '[':
+			inRow = true
+		case b == ';':
+			inRow = false
+		case inRow && b >= '0' && b <= '9':
+			row = row*10 + int(b-'0')
+		}
+	}
+
+	return row - 1, nil
+}
@@ -1,15 +1,52 @@
 package ui
 
-// DashboardLayout implements the multi-panel dashboard layout strategy
-type DashboardLayout struct{}
+// DashboardLayout implements the multi-panel dashboard layout strategy.
+// Its proportions come from an active LayoutProfile instead of being
+// hardcoded, so /focus, the Alt+Up/Down grow/shrink bindings, and Alt+H/V
+// orientation flip (see input.Handler) all just mutate profile.
+type DashboardLayout struct {
+	profile LayoutProfile
+}
 
-// NewDashboardLayout creates a new dashboard layout strategy
+// NewDashboardLayout creates a dashboard layout strategy using the
+// "dashboard" built-in profile.
 func NewDashboardLayout() *DashboardLayout {
-	return &DashboardLayout{}
+	return NewDashboardLayoutWithProfile(DefaultLayoutProfile())
+}
+
+// NewDashboardLayoutWithProfile creates a dashboard layout strategy using
+// profile's panel visibility, split ratios, and orientation.
+func NewDashboardLayoutWithProfile(profile LayoutProfile) *DashboardLayout {
+	return &DashboardLayout{profile: profile}
+}
+
+// Profile returns the active LayoutProfile, e.g. so it can be persisted on
+// exit.
+func (d *DashboardLayout) Profile() LayoutProfile {
+	return d.profile
+}
+
+// Grow widens the conversation panel (or, in a Vertical profile, makes it
+// taller) by 5 percentage points, at the info panels' expense.
+func (d *DashboardLayout) Grow() {
+	d.profile.ConvPercent = clampPercent(d.profile.ConvPercent + 5)
+}
+
+// Shrink is Grow's inverse.
+func (d *DashboardLayout) Shrink() {
+	d.profile.ConvPercent = clampPercent(d.profile.ConvPercent - 5)
 }
 
-// Calculate computes the dashboard layout with improved proportions
-func (d *DashboardLayout) Calculate(width, height int) *Layout {
+// FlipOrientation toggles between the conversation panel sitting beside
+// the info panels and sitting above them.
+func (d *DashboardLayout) FlipOrientation() {
+	d.profile.Vertical = !d.profile.Vertical
+}
+
+// Calculate computes the dashboard layout from the active profile. The
+// dashboard always takes the full terminal, so the content hint is only
+// consulted for the tab strip.
+func (d *DashboardLayout) Calculate(width, height int, hint ContentSizeHint) *Layout {
 	layout := &Layout{
 		Width:  width,
 		Height: height,
@@ -19,30 +56,57 @@ func (d *DashboardLayout) Calculate(width, height int) *Layout {
 	layout.HeaderStartY = 0
 	layout.HeaderHeight = 6
 
-	// Input: 3 rows at bottom
-	layout.InputHeight = 3
+	// Input: 3 rows at bottom, growing for an in-progress multiline compose
+	layout.InputHeight = inputHeightFor(3, hint)
 	layout.InputStartY = height - layout.InputHeight
 
-	// Content area: everything between header and input
-	layout.ContentStartY = layout.HeaderHeight
+	// Tab strip: 1 row above the content area, only when there's more than
+	// one tab open
+	layout.TabsStartY = layout.HeaderHeight
+	if hint.ShowTabs {
+		layout.TabsHeight = 1
+	}
+
+	// Content area: everything between the tab strip and input
+	layout.ContentStartY = layout.TabsStartY + layout.TabsHeight
 	layout.ContentHeight = layout.InputStartY - layout.ContentStartY
 
-	// Responsive split based on terminal width
-	convPercent, _ := calculateSplitRatio(width)
+	convPercent := clampPercent(d.profile.ConvPercent)
+	weatherPercent := clampPercent(d.profile.WeatherPercent)
+
+	if d.profile.Vertical {
+		// Conversation on top, info panels stacked below it, everything
+		// full width.
+		layout.ConvStartX = 0
+		layout.ConvWidth = width
+		layout.ConvHeight = layout.ContentHeight * convPercent / 100
 
-	// Split content horizontally: responsive conversation vs info panels
-	layout.ConvStartX = 0
-	layout.ConvWidth = width * convPercent / 100
+		layout.InfoStartX = 0
+		layout.InfoWidth = width
 
-	layout.InfoStartX = layout.ConvWidth
-	layout.InfoWidth = width - layout.ConvWidth
+		infoStartY := layout.ContentStartY + layout.ConvHeight
+		infoHeight := layout.ContentHeight - layout.ConvHeight
 
-	// Split info panels vertically: 35% weather, 65% news (improved from 30/70)
-	layout.WeatherStartY = layout.ContentStartY
-	layout.WeatherHeight = layout.ContentHeight * 35 / 100
+		layout.WeatherStartY = infoStartY
+		layout.WeatherHeight = infoHeight * weatherPercent / 100
 
-	layout.NewsStartY = layout.WeatherStartY + layout.WeatherHeight
-	layout.NewsHeight = layout.ContentHeight - layout.WeatherHeight
+		layout.NewsStartY = layout.WeatherStartY + layout.WeatherHeight
+		layout.NewsHeight = infoHeight - layout.WeatherHeight
+	} else {
+		// Conversation beside the info panels.
+		layout.ConvStartX = 0
+		layout.ConvWidth = width * convPercent / 100
+		layout.ConvHeight = layout.ContentHeight
+
+		layout.InfoStartX = layout.ConvWidth
+		layout.InfoWidth = width - layout.ConvWidth
+
+		layout.WeatherStartY = layout.ContentStartY
+		layout.WeatherHeight = layout.ContentHeight * weatherPercent / 100
+
+		layout.NewsStartY = layout.WeatherStartY + layout.WeatherHeight
+		layout.NewsHeight = layout.ContentHeight - layout.WeatherHeight
+	}
 
 	return layout
 }
@@ -71,17 +135,18 @@ func (d *DashboardLayout) GetMode() LayoutMode {
 	return LayoutModeDashboard
 }
 
-// ShouldRenderWeather returns true for dashboard mode
+// ShouldRenderWeather returns the active profile's weather visibility
 func (d *DashboardLayout) ShouldRenderWeather() bool {
-	return true
+	return d.profile.ShowWeather
 }
 
-// ShouldRenderNews returns true for dashboard mode
+// ShouldRenderNews returns the active profile's news visibility
 func (d *DashboardLayout) ShouldRenderNews() bool {
-	return true
+	return d.profile.ShowNews
 }
 
-// GetHelpText returns help text for the status bar
+// GetHelpText returns help text for the status bar, naming the active
+// profile and the bindings that adjust it
 func (d *DashboardLayout) GetHelpText() string {
-	return "[Ctrl+T: Toggle] [→/←: News] [/help: Commands]"
+	return "[" + d.profile.Name + "] [Alt+↑/↓: Grow/Shrink] [Alt+H/V: Orientation] [/focus <name>] [Ctrl+T: Toggle] [→/←: News] [/help: Commands]"
 }
@@ -8,8 +8,10 @@ func NewMinimalLayout() *MinimalLayout {
 	return &MinimalLayout{}
 }
 
-// Calculate computes the minimal layout with full-width conversation
-func (m *MinimalLayout) Calculate(width, height int) *Layout {
+// Calculate computes the minimal layout with full-width conversation. The
+// minimal layout always takes the full terminal, so the content hint is
+// unused.
+func (m *MinimalLayout) Calculate(width, height int, hint ContentSizeHint) *Layout {
 	layout := &Layout{
 		Width:  width,
 		Height: height,
@@ -19,17 +21,25 @@ func (m *MinimalLayout) Calculate(width, height int) *Layout {
 	layout.HeaderStartY = 0
 	layout.HeaderHeight = 1
 
-	// Input: 3 rows at bottom
-	layout.InputHeight = 3
+	// Input: 3 rows at bottom, growing for an in-progress multiline compose
+	layout.InputHeight = inputHeightFor(3, hint)
 	layout.InputStartY = height - layout.InputHeight
 
-	// Content area: everything between header and input
-	layout.ContentStartY = layout.HeaderHeight
+	// Tab strip: 1 row above the content area, only when there's more than
+	// one tab open
+	layout.TabsStartY = layout.HeaderHeight
+	if hint.ShowTabs {
+		layout.TabsHeight = 1
+	}
+
+	// Content area: everything between the tab strip and input
+	layout.ContentStartY = layout.TabsStartY + layout.TabsHeight
 	layout.ContentHeight = layout.InputStartY - layout.ContentStartY
 
 	// Full-width conversation panel (no side panels)
 	layout.ConvStartX = 0
 	layout.ConvWidth = width
+	layout.ConvHeight = layout.ContentHeight
 
 	// No info panels in minimal mode
 	layout.InfoStartX = 0
@@ -63,5 +73,5 @@ func (m *MinimalLayout) ShouldRenderNews() bool {
 
 // GetHelpText returns help text for the status bar
 func (m *MinimalLayout) GetHelpText() string {
-	return "[Ctrl+T: Toggle] [/weather: Quick Info] [/help: Commands]"
+	return "[Ctrl+T: Toggle] [Ctrl+N/W: Tabs] [Ctrl+Tab: Next] [/weather: Quick Info] [/help: Commands]"
 }
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LayoutProfile is a named, data-driven description of how the dashboard
+// panels split the screen: which of the optional panels are shown, how
+// much width the conversation panel gets versus the info panels, how the
+// info panels in turn split between weather and news, and whether the
+// conversation panel runs beside or above them. Where DashboardLayout used
+// to hardcode these proportions (calculateSplitRatio, a fixed 35/65
+// weather/news split), a LayoutProfile parameterizes them so a user can
+// tune or add one without touching Go.
+type LayoutProfile struct {
+	Name string `yaml:"-"`
+
+	ShowWeather bool `yaml:"show_weather"`
+	ShowNews    bool `yaml:"show_news"`
+
+	// ConvPercent is the conversation panel's share of the split axis, 10-90.
+	// The remainder goes to the info panels (weather + news).
+	ConvPercent int `yaml:"conv_percent"`
+
+	// WeatherPercent is the weather panel's share of the remaining info
+	// area, 0-100; news gets what's left. Unused when ShowWeather is false.
+	WeatherPercent int `yaml:"weather_percent"`
+
+	// Vertical stacks the conversation panel above the info panels instead
+	// of beside them. Alt+H/Alt+V flip this at runtime.
+	Vertical bool `yaml:"vertical"`
+}
+
+// builtinLayoutProfiles are the named presets the request asks for:
+// "dashboard" (the original 50/35 split), "minimal" (conversation only),
+// "focus-chat" (conversation dominant, info panels shrunk), and
+// "focus-news" (no weather, news given real room).
+var builtinLayoutProfiles = map[string]LayoutProfile{
+	"dashboard": {
+		Name: "dashboard", ShowWeather: true, ShowNews: true,
+		ConvPercent: 50, WeatherPercent: 35,
+	},
+	"minimal": {
+		Name: "minimal", ShowWeather: false, ShowNews: false,
+		ConvPercent: 100,
+	},
+	"focus-chat": {
+		Name: "focus-chat", ShowWeather: true, ShowNews: true,
+		ConvPercent: 75, WeatherPercent: 40,
+	},
+	"focus-news": {
+		Name: "focus-news", ShowWeather: false, ShowNews: true,
+		ConvPercent: 40,
+	},
+}
+
+// DefaultLayoutProfile is used when no profile name resolves to anything -
+// the same 50/35 split DashboardLayout always used before profiles
+// existed.
+func DefaultLayoutProfile() LayoutProfile {
+	return builtinLayoutProfiles["dashboard"]
+}
+
+// layoutProfilesDir returns $XDG_CONFIG_HOME/pace/layouts (or
+// ~/.pace/layouts), matching themesDir's layout under the main config
+// directory.
+func layoutProfilesDir() (string, error) {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "pace", "layouts"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".pace", "layouts"), nil
+}
+
+// LoadLayoutProfile resolves a profile by name: built-in presets are
+// returned directly, otherwise a "<name>.yaml" file is looked up under the
+// layout profiles directory. Falls back to DefaultLayoutProfile if name is
+// empty or unresolvable.
+func LoadLayoutProfile(name string) (LayoutProfile, error) {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if name == "" {
+		return DefaultLayoutProfile(), nil
+	}
+
+	if builtin, ok := builtinLayoutProfiles[name]; ok {
+		return builtin, nil
+	}
+
+	dir, err := layoutProfilesDir()
+	if err != nil {
+		return DefaultLayoutProfile(), err
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DefaultLayoutProfile(), fmt.Errorf("unknown layout profile %q, using dashboard", name)
+	}
+
+	profile := DefaultLayoutProfile() // start from defaults so a partial profile still works
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return DefaultLayoutProfile(), fmt.Errorf("failed to load layout profile %q: %w", name, err)
+	}
+	profile.Name = name
+
+	return profile, nil
+}
+
+// clampPercent keeps a split percentage within a usable range - past these
+// bounds a panel would be too narrow to render its border and a line of
+// content.
+func clampPercent(p int) int {
+	if p < 10 {
+		return 10
+	}
+	if p > 90 {
+		return 90
+	}
+	return p
+}
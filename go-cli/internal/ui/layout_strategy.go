@@ -1,11 +1,17 @@
 package ui
 
+import (
+	"strconv"
+	"strings"
+)
+
 // LayoutMode represents different UI layout modes
 type LayoutMode int
 
 const (
 	LayoutModeDashboard LayoutMode = iota
 	LayoutModeMinimal
+	LayoutModeAdaptive
 )
 
 // String returns the string representation of the layout mode
@@ -15,27 +21,98 @@ func (m LayoutMode) String() string {
 		return "dashboard"
 	case LayoutModeMinimal:
 		return "minimal"
+	case LayoutModeAdaptive:
+		return "adaptive"
 	default:
 		return "unknown"
 	}
 }
 
-// ParseLayoutMode parses a string into a LayoutMode
+// ParseLayoutMode parses a string into a LayoutMode. Adaptive mode is
+// specified as "adaptive" or "adaptive:N%" (e.g. "adaptive:~40%", fzf's
+// `--height ~40%` syntax) - the percentage itself is parsed separately by
+// ParseAdaptiveHeightPercent.
 func ParseLayoutMode(s string) LayoutMode {
-	switch s {
-	case "minimal", "min":
+	switch {
+	case s == "minimal" || s == "min":
 		return LayoutModeMinimal
-	case "dashboard", "dash":
+	case s == "dashboard" || s == "dash":
 		return LayoutModeDashboard
+	case s == "adaptive" || strings.HasPrefix(s, "adaptive:"):
+		return LayoutModeAdaptive
 	default:
 		return LayoutModeDashboard // Default to dashboard
 	}
 }
 
+// DefaultAdaptiveHeightPercent is used when an adaptive mode string carries
+// no explicit percentage.
+const DefaultAdaptiveHeightPercent = 40
+
+// ParseAdaptiveHeightPercent extracts the max-height percentage from an
+// adaptive mode string such as "adaptive:40%" or "adaptive:~40%". Returns
+// DefaultAdaptiveHeightPercent if the string carries no usable percentage.
+func ParseAdaptiveHeightPercent(s string) int {
+	if !strings.HasPrefix(s, "adaptive:") {
+		return DefaultAdaptiveHeightPercent
+	}
+
+	spec := strings.TrimPrefix(s, "adaptive:")
+	spec = strings.TrimPrefix(spec, "~")
+	spec = strings.TrimSuffix(spec, "%")
+
+	percent, err := strconv.Atoi(spec)
+	if err != nil || percent <= 0 {
+		return DefaultAdaptiveHeightPercent
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// ContentSizeHint describes how many rows each panel's current content
+// actually needs, so layouts that size themselves to content (e.g.
+// AdaptiveLayout) don't have to reserve more of the terminal than necessary.
+type ContentSizeHint struct {
+	ConversationLines int
+	WeatherLines      int
+	NewsLines         int
+
+	// ShowTabs is true when more than one tab is open, so layouts should
+	// reserve a row for RenderTabs above the conversation panel.
+	ShowTabs bool
+
+	// ComposeLines is how many lines of a multiline message (started with
+	// a trailing "\" or Alt+Enter) have been composed so far, so the input
+	// panel can grow to show them instead of just the line being typed.
+	ComposeLines int
+}
+
+// maxComposeExtraLines caps how many extra rows the input panel will grow
+// by to show an in-progress multiline composition - past this it scrolls
+// instead of eating into the panels above it.
+const maxComposeExtraLines = 3
+
+// inputHeightFor adds hint.ComposeLines (clamped to maxComposeExtraLines) on
+// top of a layout's normal input panel height, so composing a multiline
+// message grows the panel instead of clipping it.
+func inputHeightFor(base int, hint ContentSizeHint) int {
+	extra := hint.ComposeLines
+	if extra < 0 {
+		extra = 0
+	}
+	if extra > maxComposeExtraLines {
+		extra = maxComposeExtraLines
+	}
+	return base + extra
+}
+
 // LayoutStrategy defines the interface for different layout modes
 type LayoutStrategy interface {
-	// Calculate computes the layout based on terminal dimensions
-	Calculate(width, height int) *Layout
+	// Calculate computes the layout based on terminal dimensions and a hint
+	// about how much vertical space the current content needs.
+	Calculate(width, height int, hint ContentSizeHint) *Layout
 
 	// GetMode returns the layout mode
 	GetMode() LayoutMode
@@ -0,0 +1,318 @@
+// Package markdown renders a small, chat-oriented subset of Markdown to
+// ANSI-decorated terminal lines. It replaces the old parseMarkdown found in
+// ui/panels.go, which only handled headings, bold, and italic, and broke
+// line-wrapping because it measured already-styled text with len() instead
+// of its visible width.
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/ui/theme"
+)
+
+// Raw ANSI codes. Kept local (rather than imported from ui) so this package
+// has no dependency on ui - see the same convention in the theme package.
+const (
+	ansiReset     = "\033[0m"
+	ansiBold      = "\033[1m"
+	ansiUnderline = "\033[4m"
+)
+
+// StyledLine is one rendered output line. Len is the line's visible width -
+// Text's length ignoring embedded ANSI escape codes - so callers like
+// wrapText and RenderConversation's auto-scroll math can size panels
+// correctly without re-parsing the ANSI codes themselves.
+type StyledLine struct {
+	Text string
+	Len  int
+}
+
+// Highlighter styles a single line of code from a fenced block. Register
+// one per fence language tag with RegisterHighlighter to plug in real
+// syntax highlighting (e.g. backed by chroma) for languages like Go,
+// Python, or JSON; unregistered languages fall back to defaultHighlight.
+type Highlighter func(line string, th *theme.ColorTheme) string
+
+var highlighters = map[string]Highlighter{}
+
+// RegisterHighlighter installs a Highlighter for the given fence language
+// tag (e.g. "go", "python", "json"), overwriting any previous one.
+func RegisterHighlighter(lang string, h Highlighter) {
+	highlighters[strings.ToLower(lang)] = h
+}
+
+func highlighterFor(lang string) Highlighter {
+	if h, ok := highlighters[strings.ToLower(lang)]; ok {
+		return h
+	}
+	return defaultHighlight
+}
+
+func defaultHighlight(line string, th *theme.ColorTheme) string {
+	if line == "" {
+		return ""
+	}
+	return th.Code + line + ansiReset
+}
+
+var (
+	fenceRegex      = regexp.MustCompile("^```\\s*([a-zA-Z0-9_+-]*)\\s*$")
+	orderedRegex    = regexp.MustCompile(`^(\s*)(\d+)\.\s+(.*)$`)
+	unorderedRegex  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	blockquoteRegex = regexp.MustCompile(`^(\s*)>\s?(.*)$`)
+	hruleRegex      = regexp.MustCompile(`^(-{3,}|\*{3,}|_{3,})$`)
+
+	linkRegex       = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+	inlineCodeRegex = regexp.MustCompile("`([^`]+)`")
+	boldRegex       = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	italicRegex     = regexp.MustCompile(`(^|[^*])\*([^*]+)\*`)
+)
+
+// Render parses text as Markdown and wraps it to width, returning one
+// StyledLine per visible output line. Unlike the old parseMarkdown, this is
+// a line-by-line block parser: each source line is classified (fence,
+// list item, blockquote, rule, heading, or paragraph) before its inline
+// styling and wrapping are applied, rather than running regexes over the
+// whole blob.
+func Render(text string, width int, th *theme.ColorTheme) []StyledLine {
+	if width < 1 {
+		width = 1
+	}
+
+	text = strings.ReplaceAll(text, "\\n", "\n")
+
+	var out []StyledLine
+	inFence := false
+	fenceLang := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		if m := fenceRegex.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			inFence = !inFence
+			if inFence {
+				fenceLang = m[1]
+			} else {
+				fenceLang = ""
+			}
+			continue
+		}
+
+		if inFence {
+			styled := highlighterFor(fenceLang)(line, th)
+			out = append(out, StyledLine{Text: styled, Len: visibleLen(line)})
+			continue
+		}
+
+		out = append(out, renderLine(line, width, th)...)
+	}
+
+	return out
+}
+
+// renderLine classifies and renders a single source line (outside of a
+// fenced code block) into one or more wrapped StyledLines.
+func renderLine(line string, width int, th *theme.ColorTheme) []StyledLine {
+	trimmed := strings.TrimRight(line, " \t")
+
+	switch {
+	case strings.TrimSpace(trimmed) == "":
+		return []StyledLine{{Text: "", Len: 0}}
+
+	case hruleRegex.MatchString(strings.TrimSpace(trimmed)):
+		rule := strings.Repeat("─", width)
+		return []StyledLine{{Text: th.Dim + rule + ansiReset, Len: width}}
+
+	case strings.HasPrefix(strings.TrimSpace(trimmed), "## "):
+		content := applyInline(strings.TrimSpace(trimmed)[3:], th)
+		return wrapSingle(th.HeadingH2+content+ansiReset, width)
+
+	case strings.HasPrefix(strings.TrimSpace(trimmed), "# "):
+		content := applyInline(strings.TrimSpace(trimmed)[2:], th)
+		return wrapSingle(th.HeadingH1+content+ansiReset, width)
+	}
+
+	if m := blockquoteRegex.FindStringSubmatch(trimmed); m != nil {
+		indent := visibleLen(m[1])
+		content := applyInline(m[2], th)
+		marker := th.Dim + "│ " + ansiReset
+		return wrapWithMarker(marker, marker, indent, content, width)
+	}
+
+	if m := orderedRegex.FindStringSubmatch(trimmed); m != nil {
+		indent := visibleLen(m[1])
+		content := applyInline(m[3], th)
+		marker := m[2] + ". "
+		return wrapWithMarker(marker, strings.Repeat(" ", visibleLen(marker)), indent, content, width)
+	}
+
+	if m := unorderedRegex.FindStringSubmatch(trimmed); m != nil {
+		indent := visibleLen(m[1])
+		content := applyInline(m[2], th)
+		const marker = "• "
+		return wrapWithMarker(marker, strings.Repeat(" ", visibleLen(marker)), indent, content, width)
+	}
+
+	return wrapSingle(applyInline(trimmed, th), width)
+}
+
+// applyInline renders inline styles - links, inline code, bold, and italic -
+// on a single line of text, in an order chosen so each pass's ANSI output
+// can't be mistaken for Markdown syntax by a later pass.
+func applyInline(s string, th *theme.ColorTheme) string {
+	s = linkRegex.ReplaceAllStringFunc(s, func(m string) string {
+		parts := linkRegex.FindStringSubmatch(m)
+		text, url := parts[1], parts[2]
+		return ansiUnderline + th.Link + text + ansiReset + " " + th.Dim + "(" + url + ")" + ansiReset
+	})
+	s = inlineCodeRegex.ReplaceAllString(s, th.Code+"$1"+ansiReset)
+	s = boldRegex.ReplaceAllString(s, ansiBold+"$1"+ansiReset)
+	s = italicRegex.ReplaceAllString(s, "$1"+th.Emphasis+"$2"+ansiReset)
+	return s
+}
+
+// wrapSingle word-wraps already-inline-styled text with no continuation
+// indent.
+func wrapSingle(styled string, width int) []StyledLine {
+	return wrapWithMarker("", "", 0, styled, width)
+}
+
+// wrapWithMarker word-wraps styled text to width, prefixing the first
+// wrapped line with markerFirst and subsequent lines with markerRest
+// (normally markerRest is markerFirst's visible width in spaces, so
+// wrapped list/quote continuations line up under the first line's text),
+// both shifted right by indent spaces.
+func wrapWithMarker(markerFirst, markerRest string, indent int, styled string, width int) []StyledLine {
+	pad := strings.Repeat(" ", indent)
+	avail := width - indent - visibleLen(markerFirst)
+	if avail < 1 {
+		avail = 1
+	}
+
+	segments := wrapByVisibleLen(styled, avail)
+
+	out := make([]StyledLine, 0, len(segments))
+	for i, seg := range segments {
+		marker := markerRest
+		if i == 0 {
+			marker = markerFirst
+		}
+		text := pad + marker + seg
+		out = append(out, StyledLine{Text: text, Len: indent + visibleLen(marker) + visibleLen(seg)})
+	}
+	return out
+}
+
+// wrapByVisibleLen word-wraps s (which may contain embedded ANSI escape
+// codes) to width visible characters. Escape codes never contain
+// whitespace, so splitting on whitespace still finds real word boundaries
+// even though len(word) would overcount a styled word's width.
+func wrapByVisibleLen(s string, width int) []string {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	cur, curLen := "", 0
+
+	flush := func() {
+		if cur != "" {
+			lines = append(lines, cur)
+			cur, curLen = "", 0
+		}
+	}
+
+	for _, word := range words {
+		wLen := visibleLen(word)
+
+		if wLen > width {
+			flush()
+			remaining := word
+			// splitVisible must always consume at least one visible
+			// character per iteration - at width 1 there's no room left
+			// for the "-" continuation marker, so this can split off the
+			// full width rather than width-1, but it still makes progress.
+			splitAt := width - 1
+			if splitAt < 1 {
+				splitAt = 1
+			}
+			for visibleLen(remaining) > width {
+				var head string
+				head, remaining = splitVisible(remaining, splitAt)
+				lines = append(lines, head+"-")
+			}
+			cur, curLen = remaining, visibleLen(remaining)
+			continue
+		}
+
+		switch {
+		case cur == "":
+			cur, curLen = word, wLen
+		case curLen+1+wLen <= width:
+			cur += " " + word
+			curLen += 1 + wLen
+		default:
+			lines = append(lines, cur)
+			cur, curLen = word, wLen
+		}
+	}
+	flush()
+
+	if len(lines) == 0 {
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// visibleLen returns the printable width of s, ignoring embedded ANSI
+// escape sequences.
+func visibleLen(s string) int {
+	n := 0
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case inEscape:
+			if r == 'm' {
+				inEscape = false
+			}
+		case r == '\033':
+			inEscape = true
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// splitVisible splits s after its first n visible characters, keeping any
+// ANSI escape codes intact in whichever half they fall in.
+func splitVisible(s string, n int) (head, tail string) {
+	runes := []rune(s)
+	var sb strings.Builder
+	count := 0
+	inEscape := false
+
+	i := 0
+	for ; i < len(runes); i++ {
+		r := runes[i]
+		if inEscape {
+			sb.WriteRune(r)
+			if r == 'm' {
+				inEscape = false
+			}
+			continue
+		}
+		if r == '\033' {
+			inEscape = true
+			sb.WriteRune(r)
+			continue
+		}
+		if count >= n {
+			break
+		}
+		sb.WriteRune(r)
+		count++
+	}
+	return sb.String(), string(runes[i:])
+}
@@ -0,0 +1,51 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Regression test for a hang: wrapByVisibleLen's long-word branch split off
+// width-1 visible characters per iteration, which became zero characters at
+// width 1 and spun forever without ever shrinking remaining.
+func TestWrapByVisibleLenMakesProgressAtWidthOne(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		word  string
+		want  []string
+	}{
+		{"width 1", 1, "helloworld", []string{"h-", "e-", "l-", "l-", "o-", "w-", "o-", "r-", "l-", "d"}},
+		{"width 2", 2, "helloworld", []string{"h-", "e-", "l-", "l-", "o-", "w-", "o-", "r-", "ld"}},
+		{"width 3", 3, "helloworld", []string{"he-", "ll-", "ow-", "or-", "ld"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wrapByVisibleLen(tt.word, tt.width)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("wrapByVisibleLen(%q, %d) = %q, want %q", tt.word, tt.width, got, tt.want)
+			}
+			for _, line := range got {
+				if visibleLen(line) > tt.width {
+					t.Errorf("line %q exceeds width %d", line, tt.width)
+				}
+			}
+		})
+	}
+}
+
+func TestWrapByVisibleLenWidthOneDoesNotHang(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		wrapByVisibleLen("helloworld this is a longer sentence", 1)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("wrapByVisibleLen(width=1) did not return - infinite loop")
+	}
+}
@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
+)
+
+// Msg is anything Update can react to: a data-refresh message below, or one
+// of the Event types a Backend's PollEvent delivers (EventKey, EventMouse,
+// EventResize, EventPaste). It's an empty interface rather than a closed sum
+// type so a new message never requires touching Msg itself - the same
+// tradeoff Bubble Tea's tea.Msg makes.
+type Msg interface{}
+
+// Cmd is a deferred side effect: Update returns one instead of doing the
+// work itself, and whoever drives the model runs it and feeds whatever Msg
+// it produces back into Update. A nil Cmd means there's nothing to do.
+type Cmd func() Msg
+
+// TimeMsg, WeatherMsg, NewsMsg and ConversationMsg carry a manager's latest
+// data into Update - the same payloads eventLoop used to assign onto
+// UIState's fields directly before Model existed.
+type TimeMsg struct{ Data managers.TimeData }
+type WeatherMsg struct{ Data managers.WeatherData }
+type NewsMsg struct{ Data managers.NewsData }
+type ConversationMsg struct{ Data managers.ConversationData }
+
+// ConnStateMsg reports the client's current connection/reconnect status.
+type ConnStateMsg struct {
+	State     client.ConnectionState
+	Reconnect client.ReconnectStatus
+}
+
+// ModeMsg switches the active layout mode (dashboard/minimal/adaptive).
+type ModeMsg struct{ Mode LayoutMode }
+
+// SessionMsg replaces the tab/session state the tab strip renders.
+type SessionMsg struct{ Session *AppSession }
+
+// PanelGrowMsg and PanelShrinkMsg resize the conversation panel against the
+// info panels, one step at a time. A no-op outside dashboard mode.
+type PanelGrowMsg struct{}
+type PanelShrinkMsg struct{}
+
+// FlipOrientationMsg toggles the conversation panel between sitting beside
+// the info panels and sitting above them. A no-op outside dashboard mode.
+type FlipOrientationMsg struct{}
+
+// FocusProfileMsg switches to a named LayoutProfile, entering dashboard
+// mode if the model wasn't already in it.
+type FocusProfileMsg struct{ Name string }
+
+// ComposeMsg reports the current text of an in-progress multiline message
+// (see UIState.ComposeText); an empty Text means composition isn't active.
+type ComposeMsg struct{ Text string }
+
+// Model is an Elm-style Init/Update/View wrapper around the existing
+// Renderer/UIState pair. Update folds an incoming Msg into state without
+// touching the screen; View renders the result to a single string for the
+// caller to paint. It's this project's own equivalent of a Bubble Tea
+// program rather than an adoption of that library: Backend and
+// LayoutStrategy already own terminal capability detection, resize, and
+// mouse/paste events (see backend.go), so Model is the one piece that was
+// still missing - a single place state mutation and rendering go through
+// instead of being scattered across eventLoop's switch cases.
+type Model struct {
+	renderer *Renderer
+	state    *UIState
+}
+
+// NewModel wraps an existing Renderer/UIState pair - callers construct both
+// exactly as they always have.
+func NewModel(renderer *Renderer, state *UIState) *Model {
+	return &Model{renderer: renderer, state: state}
+}
+
+// Init returns the Cmd that kicks off the program. Data refreshes and
+// animation ticks here are already driven by the manager goroutines the
+// caller starts independently, so there's nothing to schedule - Init exists
+// to complete the Init/Update/View trio callers expect.
+func (m *Model) Init() Cmd {
+	return nil
+}
+
+// Update folds msg into the model's state and returns the model plus a Cmd
+// to run next. It never touches the screen - call View afterwards to get
+// the frame to paint.
+func (m *Model) Update(msg Msg) (*Model, Cmd) {
+	switch msg := msg.(type) {
+	case TimeMsg:
+		m.state.Time = msg.Data
+
+	case WeatherMsg:
+		m.state.Weather = msg.Data
+
+	case NewsMsg:
+		m.state.News = msg.Data
+
+	case ConversationMsg:
+		m.state.Conversation = msg.Data
+
+	case ConnStateMsg:
+		m.state.ConnState = msg.State
+		m.state.Reconnect = msg.Reconnect
+
+	case ModeMsg:
+		m.renderer.SetLayoutMode(msg.Mode)
+		m.state.LayoutMode = msg.Mode
+
+	case SessionMsg:
+		m.state.Session = msg.Session
+
+	case PanelGrowMsg:
+		m.renderer.GrowPanel()
+
+	case PanelShrinkMsg:
+		m.renderer.ShrinkPanel()
+
+	case FlipOrientationMsg:
+		m.renderer.FlipOrientation()
+
+	case ComposeMsg:
+		m.state.ComposeText = msg.Text
+
+	case FocusProfileMsg:
+		profile, err := LoadLayoutProfile(msg.Name)
+		if err != nil {
+			logging.Get("ui").Warn("failed to load layout profile", "name", msg.Name, "error", err)
+		}
+		m.renderer.SetLayoutProfile(profile)
+		m.renderer.SetLayoutMode(LayoutModeDashboard)
+		m.state.LayoutMode = LayoutModeDashboard
+
+	case EventResize:
+		m.renderer.caps.Width = msg.Width
+		m.renderer.caps.Height = msg.Height
+	}
+
+	return m, nil
+}
+
+// View renders the current state to a single string instead of painting it
+// directly - the same separation tea.Model.View keeps: what to show is
+// decided independently of when and how it reaches the terminal.
+func (m *Model) View() string {
+	return m.renderer.Frame(m.state)
+}
@@ -0,0 +1,53 @@
+package ui
+
+// Panel identifies one of the mouse-focusable panels.
+type Panel int
+
+const (
+	PanelNone Panel = iota
+	PanelConversation
+	PanelWeather
+	PanelNews
+)
+
+// PanelAt returns which panel contains the given 0-indexed screen
+// coordinate, or PanelNone if it falls outside all of them (e.g. the
+// header, tab strip, or input panel).
+func (l *Layout) PanelAt(x, y int) Panel {
+	switch {
+	case x >= l.ConvStartX && x < l.ConvStartX+l.ConvWidth &&
+		y >= l.ContentStartY && y < l.ContentStartY+l.ConvHeight:
+		return PanelConversation
+
+	case x >= l.InfoStartX && x < l.InfoStartX+l.InfoWidth &&
+		y >= l.WeatherStartY && y < l.WeatherStartY+l.WeatherHeight:
+		return PanelWeather
+
+	case x >= l.InfoStartX && x < l.InfoStartX+l.InfoWidth &&
+		y >= l.NewsStartY && y < l.NewsStartY+l.NewsHeight:
+		return PanelNews
+
+	default:
+		return PanelNone
+	}
+}
+
+// NewsHeadlineAt maps a click's 0-indexed screen row to a headline index
+// within the news panel, or -1 if y falls outside the headline list. It
+// assumes the common case of one unwrapped line per headline (as
+// RenderNews lays them out: a content row plus a spacer row), so a click on
+// a headline that wrapped onto multiple lines may resolve to a neighboring
+// entry instead of the exact line clicked.
+func NewsHeadlineAt(layout *Layout, y int) int {
+	contentRow := y - (layout.NewsStartY + 1)
+	if contentRow < 0 {
+		return -1
+	}
+
+	index := contentRow / 2 // each headline occupies a content row + a spacer row
+	if contentRow%2 != 0 {
+		return -1 // clicked on the spacer row between headlines
+	}
+
+	return index
+}
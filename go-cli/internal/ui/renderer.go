@@ -0,0 +1,286 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
+)
+
+// UIState holds all data needed for rendering
+type UIState struct {
+	Time         managers.TimeData
+	Weather      managers.WeatherData
+	News         managers.NewsData
+	Conversation managers.ConversationData
+	ConnState    client.ConnectionState
+	Reconnect    client.ReconnectStatus // Only meaningful while ConnState is StateReconnecting
+	LayoutMode   LayoutMode             // Current layout mode
+
+	// Session holds the open tabs, if the app is using tabbed conversations.
+	// Nil means tabs aren't in use, and the tab strip is never rendered.
+	Session *AppSession
+
+	// FocusedPanel is the panel the mouse last clicked, if any. It only
+	// affects border highlighting; keyboard focus is unaffected.
+	FocusedPanel Panel
+
+	// ComposeText holds the lines of an in-progress multiline message
+	// (started with a trailing "\" or Alt+Enter), joined by "\n"; empty
+	// outside of composition. RenderInput displays it above the prompt.
+	ComposeText string
+}
+
+// Renderer manages terminal rendering
+type Renderer struct {
+	backend         Backend
+	caps            *TerminalCapabilities
+	layout          *Layout
+	strategy        LayoutStrategy // Layout strategy for current mode
+	adaptivePercent int            // Max height percent used if/when switching to adaptive mode
+	theme           *ColorTheme
+	profile         LayoutProfile // Active panel split/visibility profile, dashboard mode only
+}
+
+// NewRenderer creates a new Renderer with dashboard mode and the default
+// theme, backed by the ANSI backend.
+func NewRenderer() *Renderer {
+	caps := DetectCapabilities()
+	profile := DefaultLayoutProfile()
+	strategy := NewDashboardLayoutWithProfile(profile)
+	layout := strategy.Calculate(caps.Width, caps.Height, ContentSizeHint{})
+
+	return &Renderer{
+		backend:         NewANSIBackend(caps),
+		caps:            caps,
+		layout:          layout,
+		strategy:        strategy,
+		adaptivePercent: DefaultAdaptiveHeightPercent,
+		theme:           Dark256Theme(),
+		profile:         profile,
+	}
+}
+
+// NewRendererWithMode creates a new Renderer with a specific mode and color
+// theme, backed by the ANSI backend. adaptivePercent is only used when mode
+// is LayoutModeAdaptive.
+func NewRendererWithMode(mode LayoutMode, adaptivePercent int, theme *ColorTheme) *Renderer {
+	caps := DetectCapabilities()
+	if adaptivePercent <= 0 {
+		adaptivePercent = DefaultAdaptiveHeightPercent
+	}
+	if theme == nil {
+		theme = Dark256Theme()
+	}
+
+	profile := DefaultLayoutProfile()
+	var strategy LayoutStrategy
+	switch mode {
+	case LayoutModeMinimal:
+		strategy = NewMinimalLayout()
+	case LayoutModeAdaptive:
+		strategy = NewAdaptiveLayout(adaptivePercent)
+	default:
+		strategy = NewDashboardLayoutWithProfile(profile)
+	}
+
+	layout := strategy.Calculate(caps.Width, caps.Height, ContentSizeHint{})
+
+	return &Renderer{
+		backend:         NewANSIBackend(caps),
+		caps:            caps,
+		layout:          layout,
+		strategy:        strategy,
+		adaptivePercent: adaptivePercent,
+		theme:           theme,
+		profile:         profile,
+	}
+}
+
+// NewRendererWithBackend creates a Renderer driven by an arbitrary Backend
+// (e.g. a TcellBackend) instead of the default ANSI one.
+func NewRendererWithBackend(backend Backend, mode LayoutMode, adaptivePercent int, theme *ColorTheme) *Renderer {
+	caps := DetectCapabilities()
+	if w, h := backend.Size(); w > 0 && h > 0 {
+		caps.Width, caps.Height = w, h
+	}
+	if adaptivePercent <= 0 {
+		adaptivePercent = DefaultAdaptiveHeightPercent
+	}
+	if theme == nil {
+		theme = Dark256Theme()
+	}
+
+	profile := DefaultLayoutProfile()
+	var strategy LayoutStrategy
+	switch mode {
+	case LayoutModeMinimal:
+		strategy = NewMinimalLayout()
+	case LayoutModeAdaptive:
+		strategy = NewAdaptiveLayout(adaptivePercent)
+	default:
+		strategy = NewDashboardLayoutWithProfile(profile)
+	}
+
+	layout := strategy.Calculate(caps.Width, caps.Height, ContentSizeHint{})
+
+	return &Renderer{
+		backend:         backend,
+		caps:            caps,
+		layout:          layout,
+		strategy:        strategy,
+		adaptivePercent: adaptivePercent,
+		theme:           theme,
+		profile:         profile,
+	}
+}
+
+// SetTheme switches the renderer to a different color theme.
+func (r *Renderer) SetTheme(theme *ColorTheme) {
+	if theme == nil {
+		return
+	}
+	r.theme = theme
+}
+
+// SetLayoutMode switches the renderer to a different layout mode
+func (r *Renderer) SetLayoutMode(mode LayoutMode) {
+	switch mode {
+	case LayoutModeMinimal:
+		r.strategy = NewMinimalLayout()
+	case LayoutModeAdaptive:
+		r.strategy = NewAdaptiveLayout(r.adaptivePercent)
+	default:
+		r.strategy = NewDashboardLayoutWithProfile(r.profile)
+	}
+
+	// Recalculate layout with new strategy
+	r.layout = r.strategy.Calculate(r.caps.Width, r.caps.Height, ContentSizeHint{})
+}
+
+// SetLayoutProfile switches the active panel split/visibility profile. If
+// the renderer is currently in dashboard mode, the strategy is rebuilt
+// immediately so the next Frame reflects it; otherwise it's just remembered
+// for the next time dashboard mode is entered.
+func (r *Renderer) SetLayoutProfile(profile LayoutProfile) {
+	r.profile = profile
+	if _, ok := r.strategy.(*DashboardLayout); ok {
+		r.strategy = NewDashboardLayoutWithProfile(profile)
+		r.layout = r.strategy.Calculate(r.caps.Width, r.caps.Height, ContentSizeHint{})
+	}
+}
+
+// GrowPanel widens the conversation panel at the info panels' expense. A
+// no-op outside dashboard mode.
+func (r *Renderer) GrowPanel() {
+	if d, ok := r.strategy.(*DashboardLayout); ok {
+		d.Grow()
+		r.profile = d.Profile()
+	}
+}
+
+// ShrinkPanel is GrowPanel's inverse. A no-op outside dashboard mode.
+func (r *Renderer) ShrinkPanel() {
+	if d, ok := r.strategy.(*DashboardLayout); ok {
+		d.Shrink()
+		r.profile = d.Profile()
+	}
+}
+
+// FlipOrientation toggles the conversation panel between sitting beside
+// the info panels and sitting above them. A no-op outside dashboard mode.
+func (r *Renderer) FlipOrientation() {
+	if d, ok := r.strategy.(*DashboardLayout); ok {
+		d.FlipOrientation()
+		r.profile = d.Profile()
+	}
+}
+
+// ActiveProfile returns the active layout profile, and whether the
+// renderer is currently in dashboard mode (the only mode it applies to) -
+// e.g. so the caller can persist it on exit.
+func (r *Renderer) ActiveProfile() (LayoutProfile, bool) {
+	_, ok := r.strategy.(*DashboardLayout)
+	return r.profile, ok
+}
+
+// Frame computes the complete UI and returns it as a plain string instead
+// of writing it to the backend. It's what Model.View calls: deciding what
+// the screen should look like and actually painting it (see Paint) are two
+// separate steps here, the same split tea.Model.View keeps.
+func (r *Renderer) Frame(state *UIState) string {
+	r.caps.UpdateSize()
+	hint := EstimateContentSize(state, r.caps.Width)
+	r.layout = r.strategy.Calculate(r.caps.Width, r.caps.Height, hint)
+
+	capture := NewANSIBackend(r.caps)
+
+	RenderHeader(capture, r.layout, r.caps, state.Time, state.ConnState, state.Reconnect, r.strategy.GetMode(), r.theme)
+
+	if r.layout.TabsHeight > 0 && state.Session != nil {
+		RenderTabs(capture, r.layout, r.caps, state.Session.Tabs, state.Session.Active, r.theme)
+	}
+
+	RenderConversation(capture, r.layout, r.caps, state.Conversation, r.theme, state.FocusedPanel == PanelConversation)
+
+	if r.strategy.ShouldRenderWeather() {
+		RenderWeather(capture, r.layout, r.caps, state.Weather, r.theme, state.FocusedPanel == PanelWeather)
+	}
+
+	if r.strategy.ShouldRenderNews() {
+		RenderNews(capture, r.layout, r.caps, state.News, r.theme, state.FocusedPanel == PanelNews)
+	}
+
+	RenderInput(capture, r.layout, r.strategy.GetHelpText(), r.theme, state.ComposeText)
+
+	frame := capture.String()
+	if !r.layout.Inline {
+		frame = ClearScreen + CursorHome + frame
+	}
+	return frame
+}
+
+// Paint writes a frame produced by Frame to the backend and flushes it -
+// the one point where Model's caller actually touches the screen.
+func (r *Renderer) Paint(frame string) {
+	if r.layout.Inline {
+		r.backend.MoveTo(0, r.layout.OriginY)
+	}
+	r.backend.WriteString(frame)
+	r.backend.Flush()
+}
+
+// RenderHeaderOnly renders only the header (for time updates)
+// This doesn't clear the screen, so user input is preserved
+func (r *Renderer) RenderHeaderOnly(state *UIState) {
+	// Use DECSC (save cursor) - more widely supported
+	fmt.Print("\0337")
+	os.Stdout.Sync() // Flush before rendering
+
+	// Render just the header
+	RenderHeader(r.backend, r.layout, r.caps, state.Time, state.ConnState, state.Reconnect, r.strategy.GetMode(), r.theme)
+	r.backend.Flush()
+	os.Stdout.Sync() // Flush after rendering
+
+	// Use DECRC (restore cursor)
+	fmt.Print("\0338")
+	os.Stdout.Sync() // Ensure restore is sent
+}
+
+// Clear clears the screen
+func (r *Renderer) Clear() {
+	r.backend.Clear()
+	r.backend.Flush()
+}
+
+// GetCapabilities returns the terminal capabilities
+func (r *Renderer) GetCapabilities() *TerminalCapabilities {
+	return r.caps
+}
+
+// GetLayout returns the current panel layout, e.g. for hit-testing mouse
+// events against panel boundaries via Layout.PanelAt.
+func (r *Renderer) GetLayout() *Layout {
+	return r.layout
+}
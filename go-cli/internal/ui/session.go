@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"strconv"
+
+	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
+)
+
+// TabSession is one tab's worth of state: its own conversation, an
+// optional pinned weather/news locale (overriding whatever the global
+// managers are currently fetching), and how far the user has scrolled back
+// in it.
+type TabSession struct {
+	ID            int
+	Title         string
+	Conversation  managers.ConversationData
+	WeatherLocale string // "" means use the app-wide default locale
+	NewsLocale    string // "" means use the app-wide default locale
+	ScrollOffset  int
+}
+
+// AppSession holds every open tab and which one is active. The zero value
+// is not valid - use NewAppSession.
+type AppSession struct {
+	Tabs   []*TabSession
+	Active int
+
+	nextID int
+}
+
+// NewAppSession creates a session with a single, untitled tab.
+func NewAppSession() *AppSession {
+	s := &AppSession{}
+	s.NewTab()
+	return s
+}
+
+// ActiveTab returns the currently active tab. It's always non-nil, since
+// CloseTab refuses to close the last remaining tab.
+func (s *AppSession) ActiveTab() *TabSession {
+	return s.Tabs[s.Active]
+}
+
+// NewTab opens a new tab after the current one and switches to it.
+func (s *AppSession) NewTab() *TabSession {
+	s.nextID++
+	tab := &TabSession{ID: s.nextID, Title: tabTitle(s.nextID)}
+
+	if len(s.Tabs) == 0 {
+		s.Tabs = []*TabSession{tab}
+		s.Active = 0
+		return tab
+	}
+
+	insertAt := s.Active + 1
+	s.Tabs = append(s.Tabs, nil)
+	copy(s.Tabs[insertAt+1:], s.Tabs[insertAt:])
+	s.Tabs[insertAt] = tab
+	s.Active = insertAt
+	return tab
+}
+
+// CloseTab closes the active tab and switches to the one before it. The
+// last remaining tab can't be closed; CloseTab is a no-op when there's only
+// one left.
+func (s *AppSession) CloseTab() {
+	if len(s.Tabs) <= 1 {
+		return
+	}
+
+	s.Tabs = append(s.Tabs[:s.Active], s.Tabs[s.Active+1:]...)
+	if s.Active >= len(s.Tabs) {
+		s.Active = len(s.Tabs) - 1
+	}
+}
+
+// Next switches to the next tab, wrapping around.
+func (s *AppSession) Next() {
+	s.Active = (s.Active + 1) % len(s.Tabs)
+}
+
+// Prev switches to the previous tab, wrapping around.
+func (s *AppSession) Prev() {
+	s.Active = (s.Active - 1 + len(s.Tabs)) % len(s.Tabs)
+}
+
+// Jump switches to the nth tab (1-indexed, matching Alt+1..Alt+9). Returns
+// false if there's no tab at that position.
+func (s *AppSession) Jump(n int) bool {
+	if n < 1 || n > len(s.Tabs) {
+		return false
+	}
+	s.Active = n - 1
+	return true
+}
+
+// tabTitle returns the default title for a newly created tab.
+func tabTitle(id int) string {
+	return "Tab " + strconv.Itoa(id)
+}
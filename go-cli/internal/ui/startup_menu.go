@@ -8,45 +8,57 @@ import (
 )
 
 // ShowLayoutSelectionMenu displays the interactive layout selection menu
-// and returns the user's choice ("dashboard" or "minimal")
+// and returns the user's choice ("dashboard" or "minimal"). Input is read
+// through a Backend's event loop rather than raw os.Stdin bytes, so the
+// same menu works unchanged once a non-ANSI Backend (e.g. tcell) is
+// plugged in.
 func ShowLayoutSelectionMenu() string {
-	// Clear screen
-	fmt.Print("\033[2J\033[H")
+	caps := DetectCapabilities()
+	backend := NewANSIBackend(caps)
 
-	// Display menu
-	fmt.Print(layoutSelectionScreen())
-
-	// Set terminal to raw mode for single-key input
+	// Raw mode has to be entered here (rather than inside ANSIBackend,
+	// which doesn't own terminal mode) since this menu runs before
+	// internal/input.Handler has started.
 	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
 	if err != nil {
 		// Fallback to regular input if raw mode fails
+		fmt.Print("\033[2J\033[H" + layoutSelectionScreen())
 		return promptWithFallback()
 	}
 	defer term.Restore(int(os.Stdin.Fd()), oldState)
 
-	// Read single key
-	buf := make([]byte, 1)
+	backend.Clear()
+	backend.WriteString(layoutSelectionScreen())
+	backend.Flush()
+
 	for {
-		_, err := os.Stdin.Read(buf)
-		if err != nil {
+		ev := backend.PollEvent()
+		if ev == nil {
 			term.Restore(int(os.Stdin.Fd()), oldState)
-			return "dashboard" // Default on error
+			return "dashboard" // Default on read error
+		}
+		key, ok := ev.(EventKey)
+		if !ok {
+			continue
 		}
 
-		switch buf[0] {
+		switch key.Rune {
 		case '1':
 			term.Restore(int(os.Stdin.Fd()), oldState)
-			fmt.Print("\033[2J\033[H") // Clear screen
+			backend.Clear()
+			backend.Flush()
 			return "dashboard"
 		case '2':
 			term.Restore(int(os.Stdin.Fd()), oldState)
-			fmt.Print("\033[2J\033[H") // Clear screen
+			backend.Clear()
+			backend.Flush()
 			return "minimal"
-		case 3: // Ctrl+C
+		case '3':
 			term.Restore(int(os.Stdin.Fd()), oldState)
-			fmt.Println("\nExiting...")
-			os.Exit(0)
-		case 27: // ESC
+			backend.Clear()
+			backend.Flush()
+			return "adaptive:40%"
+		case 3, 27: // Ctrl+C, ESC
 			term.Restore(int(os.Stdin.Fd()), oldState)
 			fmt.Println("\nExiting...")
 			os.Exit(0)
@@ -70,7 +82,11 @@ func layoutSelectionScreen() string {
 │      Full-width chat-focused interface                     │
 │      Best for: Distraction-free conversations              │
 │                                                             │
-│  Press 1 or 2 to select (or Ctrl+C to exit)               │
+│  [3] Adaptive Mode                                         │
+│      Sizes itself to the current content, fzf-style        │
+│      Best for: Short, low-intrusion sessions                │
+│                                                             │
+│  Press 1, 2, or 3 to select (or Ctrl+C to exit)            │
 │  Your choice will be saved for future sessions             │
 └─────────────────────────────────────────────────────────────┘
 
@@ -80,7 +96,7 @@ Your choice: `
 // promptWithFallback is a fallback for when raw mode isn't available
 func promptWithFallback() string {
 	var choice string
-	fmt.Print("Enter 1 or 2: ")
+	fmt.Print("Enter 1, 2, or 3: ")
 	fmt.Scanln(&choice)
 
 	switch choice {
@@ -88,6 +104,8 @@ func promptWithFallback() string {
 		return "dashboard"
 	case "2":
 		return "minimal"
+	case "3":
+		return "adaptive:40%"
 	default:
 		fmt.Println("Invalid choice. Defaulting to dashboard mode.")
 		return "dashboard"
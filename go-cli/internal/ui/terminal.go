@@ -4,16 +4,18 @@ import (
 	"os"
 	"strings"
 
+	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
 	"golang.org/x/term"
 )
 
 // TerminalCapabilities holds information about terminal features
 type TerminalCapabilities struct {
-	Width          int
-	Height         int
-	SupportsColor  bool
-	SupportsUnicode bool
-	SupportsEmoji  bool
+	Width             int
+	Height            int
+	SupportsColor     bool
+	SupportsUnicode   bool
+	SupportsEmoji     bool
+	SupportsTrueColor bool // 24-bit ANSI color (\033[38;2;R;G;Bm)
 }
 
 // DetectCapabilities detects terminal capabilities
@@ -26,6 +28,11 @@ func DetectCapabilities() *TerminalCapabilities {
 		SupportsEmoji:   true, // Assume true for modern terminals
 	}
 
+	// Truecolor support is advertised via $COLORTERM by most modern terminal
+	// emulators (kitty, iTerm2, WezTerm, recent xterm/VTE based ones).
+	colorTerm := os.Getenv("COLORTERM")
+	caps.SupportsTrueColor = colorTerm == "truecolor" || colorTerm == "24bit"
+
 	// Get terminal size
 	width, height, err := term.GetSize(int(os.Stdout.Fd()))
 	if err == nil {
@@ -53,6 +60,16 @@ func DetectCapabilities() *TerminalCapabilities {
 	return caps
 }
 
+// PickAnimationForCapabilities chooses the loading animation that degrades
+// gracefully for caps: the full BrailleSpinner on a Unicode-capable
+// terminal, falling back to AsciiSpinner on a plain one.
+func PickAnimationForCapabilities(caps *TerminalCapabilities) managers.LoadingAnimation {
+	if caps != nil && !caps.SupportsUnicode {
+		return managers.AsciiSpinner{}
+	}
+	return managers.NewBrailleSpinner()
+}
+
 // UpdateSize updates the terminal size
 func (tc *TerminalCapabilities) UpdateSize() {
 	width, height, err := term.GetSize(int(os.Stdout.Fd()))
@@ -0,0 +1,18 @@
+package ui
+
+import "github.com/AlejandroE25/proPACE/go-cli/internal/ui/theme"
+
+// ColorTheme is re-exported from the theme package so existing call sites
+// (and the rest of this package, which still refers to *ColorTheme
+// everywhere) don't need to change. It lives in its own leaf package so
+// ui/markdown can depend on it without importing ui.
+type ColorTheme = theme.ColorTheme
+
+// Dark256Theme, LightTheme, SolarizedTheme, and LoadTheme are re-exported
+// for the same reason; see the theme package for their documentation.
+var (
+	Dark256Theme   = theme.Dark256Theme
+	LightTheme     = theme.LightTheme
+	SolarizedTheme = theme.SolarizedTheme
+	LoadTheme      = theme.LoadTheme
+)
@@ -0,0 +1,237 @@
+// Package theme defines the color palette shared by the UI's renderers. It
+// has no dependency on the ui package itself so that sibling packages (e.g.
+// ui/markdown) can depend on ColorTheme without creating an import cycle
+// back through ui.
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Raw ANSI codes used to build the built-in palettes below. These mirror
+// the constants in the ui package; they're kept here too (rather than
+// imported) so this package has no dependency on ui.
+const (
+	colorReset  = "\033[0m"
+	colorBold   = "\033[1m"
+	colorCyan   = "\033[36m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorRed    = "\033[31m"
+	colorWhite  = "\033[37m"
+	colorGray   = "\033[90m"
+)
+
+// ColorTheme carries the semantic colors used across the renderers, as ANSI
+// escape sequences. Built-in themes use portable 16/256-color codes;
+// user-defined themes may use 24-bit truecolor codes
+// ("\033[38;2;R;G;Bm") when the terminal advertises support for it.
+type ColorTheme struct {
+	Name string `toml:"-" json:"-"`
+
+	Fg     string `toml:"fg" json:"fg"`
+	Bg     string `toml:"bg" json:"bg"`
+	Border string `toml:"border" json:"border"`
+
+	ConversationUser      string `toml:"conversation_user" json:"conversation_user"`
+	ConversationAssistant string `toml:"conversation_assistant" json:"conversation_assistant"`
+
+	WeatherAccent string `toml:"weather_accent" json:"weather_accent"`
+	NewsAccent    string `toml:"news_accent" json:"news_accent"`
+
+	StatusOK    string `toml:"status_ok" json:"status_ok"`
+	StatusWarn  string `toml:"status_warn" json:"status_warn"`
+	StatusError string `toml:"status_error" json:"status_error"`
+
+	HeadingH1 string `toml:"heading_h1" json:"heading_h1"`
+	HeadingH2 string `toml:"heading_h2" json:"heading_h2"`
+	Emphasis  string `toml:"emphasis" json:"emphasis"`
+	Dim       string `toml:"dim" json:"dim"`
+
+	Link string `toml:"link" json:"link"`
+	Code string `toml:"code" json:"code"`
+}
+
+// rgb builds a 24-bit truecolor ANSI foreground escape sequence.
+func rgb(r, g, b int) string {
+	return fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b)
+}
+
+// Dark256Theme is the original proPACE palette, built from portable
+// 16-color ANSI codes. It's the default theme and the fallback when a
+// requested theme can't be found.
+func Dark256Theme() *ColorTheme {
+	return &ColorTheme{
+		Name: "dark256",
+
+		Fg:     colorWhite,
+		Bg:     "",
+		Border: colorCyan,
+
+		ConversationUser:      colorCyan,
+		ConversationAssistant: colorGreen,
+
+		WeatherAccent: colorYellow,
+		NewsAccent:    colorYellow,
+
+		StatusOK:    colorGreen,
+		StatusWarn:  colorYellow,
+		StatusError: colorRed,
+
+		HeadingH1: colorBold + colorYellow,
+		HeadingH2: colorBold + colorCyan,
+		Emphasis:  colorCyan,
+		Dim:       colorGray,
+
+		Link: colorCyan,
+		Code: colorYellow,
+	}
+}
+
+// LightTheme suits light-background terminals: darker accents so text
+// stays readable against a pale background.
+func LightTheme() *ColorTheme {
+	return &ColorTheme{
+		Name: "light",
+
+		Fg:     "\033[30m",
+		Bg:     "",
+		Border: "\033[34m",
+
+		ConversationUser:      "\033[34m",
+		ConversationAssistant: "\033[32m",
+
+		WeatherAccent: "\033[33m",
+		NewsAccent:    "\033[33m",
+
+		StatusOK:    "\033[32m",
+		StatusWarn:  "\033[33m",
+		StatusError: "\033[31m",
+
+		HeadingH1: colorBold + "\033[33m",
+		HeadingH2: colorBold + "\033[34m",
+		Emphasis:  "\033[34m",
+		Dim:       "\033[90m",
+
+		Link: "\033[34m",
+		Code: "\033[33m",
+	}
+}
+
+// SolarizedTheme mirrors the Solarized Dark palette using truecolor codes,
+// for terminals that advertise $COLORTERM=truecolor.
+func SolarizedTheme() *ColorTheme {
+	return &ColorTheme{
+		Name: "solarized",
+
+		Fg:     rgb(131, 148, 150), // base0
+		Bg:     rgb(0, 43, 54),     // base03
+		Border: rgb(38, 139, 210),  // blue
+
+		ConversationUser:      rgb(38, 139, 210), // blue
+		ConversationAssistant: rgb(133, 153, 0),   // green
+
+		WeatherAccent: rgb(181, 137, 0), // yellow
+		NewsAccent:    rgb(203, 75, 22), // orange
+
+		StatusOK:    rgb(133, 153, 0), // green
+		StatusWarn:  rgb(181, 137, 0), // yellow
+		StatusError: rgb(220, 50, 47), // red
+
+		HeadingH1: colorBold + rgb(181, 137, 0),
+		HeadingH2: colorBold + rgb(38, 139, 210),
+		Emphasis:  rgb(108, 113, 196), // violet
+		Dim:       rgb(88, 110, 117),  // base01
+
+		Link: rgb(38, 139, 210),  // blue
+		Code: rgb(181, 137, 0),   // yellow
+	}
+}
+
+// builtinThemes maps theme names to their constructors.
+var builtinThemes = map[string]func() *ColorTheme{
+	"dark256":   Dark256Theme,
+	"light":     LightTheme,
+	"solarized": SolarizedTheme,
+}
+
+// themesDir returns $XDG_CONFIG_HOME/pace/themes (or ~/.pace/themes),
+// matching the layout used for the main config file.
+func themesDir() (string, error) {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "pace", "themes"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".pace", "themes"), nil
+}
+
+// LoadTheme resolves a theme by name: built-in themes are returned
+// directly, otherwise a "<name>.toml" or "<name>.json" file is looked up
+// under the themes directory. Falls back to Dark256Theme if name is empty
+// or unresolvable.
+func LoadTheme(name string) (*ColorTheme, error) {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if name == "" {
+		return Dark256Theme(), nil
+	}
+
+	if builtin, ok := builtinThemes[name]; ok {
+		return builtin(), nil
+	}
+
+	dir, err := themesDir()
+	if err != nil {
+		return Dark256Theme(), err
+	}
+
+	for _, ext := range []string{".toml", ".json"} {
+		path := filepath.Join(dir, name+ext)
+		if _, statErr := os.Stat(path); statErr != nil {
+			continue
+		}
+
+		theme, loadErr := loadThemeFile(path)
+		if loadErr != nil {
+			return Dark256Theme(), fmt.Errorf("failed to load theme %q: %w", name, loadErr)
+		}
+		theme.Name = name
+		return theme, nil
+	}
+
+	return Dark256Theme(), fmt.Errorf("unknown theme %q, using dark256", name)
+}
+
+// loadThemeFile reads a user-defined theme from a TOML or JSON file.
+func loadThemeFile(path string) (*ColorTheme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	theme := Dark256Theme() // start from defaults so partial themes still work
+
+	switch filepath.Ext(path) {
+	case ".toml":
+		if _, err := toml.Decode(string(data), theme); err != nil {
+			return nil, err
+		}
+	case ".json":
+		if err := json.Unmarshal(data, theme); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported theme file extension: %s", path)
+	}
+
+	return theme, nil
+}
@@ -0,0 +1,175 @@
+package ui
+
+import "strings"
+
+// Window is a bounded rendering region within the terminal. It wraps the
+// moveCursor/drawBox/strings.Repeat bookkeeping every panel renderer used
+// to do by hand into a handful of methods, and clips writes to its own
+// bounds so callers can't accidentally spill into a neighboring panel.
+type Window struct {
+	Top, Left     int
+	Width, Height int
+	Border        bool
+	Title         string
+	BorderColor   string // overrides Theme.Border when set
+	Theme         *ColorTheme
+
+	caps         *TerminalCapabilities
+	cursor       int // next row Print() writes to, relative to the content area
+	scrollOffset int // rows hidden above the visible content area by Scroll
+}
+
+// NewWindow creates a Window for the given region, bordered in the theme's
+// default border color. When border is true, one row/column on every side
+// is reserved for DrawBorder and excluded from the content area used by
+// WriteAt/Print. Use WithBorderColor to use a panel-specific accent instead.
+func NewWindow(top, left, width, height int, border bool, title string, theme *ColorTheme, caps *TerminalCapabilities) *Window {
+	return &Window{
+		Top:    top,
+		Left:   left,
+		Width:  width,
+		Height: height,
+		Border: border,
+		Title:  title,
+		Theme:  theme,
+		caps:   caps,
+	}
+}
+
+// WithBorderColor overrides the window's border color (e.g. a panel's own
+// accent color instead of the theme's default border color) and returns the
+// window for chaining.
+func (w *Window) WithBorderColor(color string) *Window {
+	w.BorderColor = color
+	return w
+}
+
+// contentOrigin returns the absolute (x, y) the window's content area
+// starts at.
+func (w *Window) contentOrigin() (x, y int) {
+	if w.Border {
+		return w.Left + 1, w.Top + 1
+	}
+	return w.Left, w.Top
+}
+
+// contentSize returns the window's writable width and height.
+func (w *Window) contentSize() (width, height int) {
+	if w.Border {
+		return w.Width - 2, w.Height - 2
+	}
+	return w.Width, w.Height
+}
+
+// DrawBorder renders the window's border and title, if it has one.
+func (w *Window) DrawBorder() string {
+	if !w.Border {
+		return ""
+	}
+	color := w.BorderColor
+	if color == "" {
+		color = w.Theme.Border
+	}
+	return drawBox(w.Left, w.Top, w.Width, w.Height, w.Title, color, w.caps)
+}
+
+// Clear blanks the window's content area, leaving its border (if any)
+// untouched, and resets Print's cursor back to the top.
+func (w *Window) Clear() string {
+	var sb strings.Builder
+
+	x, y := w.contentOrigin()
+	cw, ch := w.contentSize()
+	for i := 0; i < ch; i++ {
+		sb.WriteString(moveCursor(x, y+i))
+		sb.WriteString(strings.Repeat(" ", cw))
+	}
+
+	w.cursor = 0
+	w.scrollOffset = 0
+	return sb.String()
+}
+
+// WriteAt writes s at (x, y) relative to the content area, clipped to the
+// window's bounds. Embedded ANSI escape codes in s don't count toward the
+// clip width, so styled text is measured the same way it's displayed.
+func (w *Window) WriteAt(x, y int, s string) string {
+	cx, cy := w.contentOrigin()
+	cw, ch := w.contentSize()
+
+	screenY := y - w.scrollOffset
+	if x < 0 || x >= cw || screenY < 0 || screenY >= ch {
+		return ""
+	}
+
+	return moveCursor(cx+x, cy+screenY) + clipVisible(s, cw-x)
+}
+
+// Print writes s at the start of the next row and advances the internal
+// cursor, so repeated calls lay content out top-to-bottom without the
+// caller tracking a row counter itself.
+func (w *Window) Print(s string) string {
+	out := w.WriteAt(0, w.cursor, s)
+	w.cursor++
+	return out
+}
+
+// Scroll shifts the window's visible content by n rows: positive values
+// scroll down, revealing earlier rows written via Print/WriteAt that had
+// scrolled off the top. It's the hook future features like scrollback and
+// overlapping windows build on.
+func (w *Window) Scroll(n int) {
+	w.scrollOffset += n
+	if w.scrollOffset < 0 {
+		w.scrollOffset = 0
+	}
+}
+
+// visibleLen returns the printable width of s, ignoring embedded ANSI
+// escape sequences - unlike len(), which counts every byte of the escape
+// codes too.
+func visibleLen(s string) int {
+	n := 0
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case inEscape:
+			if r == 'm' {
+				inEscape = false
+			}
+		case r == '\033':
+			inEscape = true
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// clipVisible truncates s to at most max printable characters, preserving
+// any embedded ANSI escape codes in full.
+func clipVisible(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	n := 0
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case inEscape:
+			sb.WriteRune(r)
+			if r == 'm' {
+				inEscape = false
+			}
+		case r == '\033':
+			inEscape = true
+			sb.WriteRune(r)
+		case n < max:
+			sb.WriteRune(r)
+			n++
+		}
+	}
+	return sb.String()
+}
@@ -1,27 +1,38 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/AlejandroE25/proPACE/go-cli/internal/client"
 	"github.com/AlejandroE25/proPACE/go-cli/internal/config"
 	"github.com/AlejandroE25/proPACE/go-cli/internal/input"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/ipc"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/logging"
 	"github.com/AlejandroE25/proPACE/go-cli/internal/managers"
+	"github.com/AlejandroE25/proPACE/go-cli/internal/shutdown"
 	"github.com/AlejandroE25/proPACE/go-cli/internal/ui"
 	"github.com/AlejandroE25/proPACE/go-cli/pkg/protocol"
 )
 
+// shutdownTimeout bounds how long Shutdown waits for in-flight goroutines
+// (fetches, the reconnect loop, the read pump) to finish before exiting.
+const shutdownTimeout = 5 * time.Second
+
 // App is the main application struct
 type App struct {
-	config   *config.Config
-	client   *client.Client
-	renderer *ui.Renderer
-	input    *input.Handler
+	config     *config.Config
+	client     *client.Client
+	renderer   *ui.Renderer
+	input      *input.Handler
+	ipc        *ipc.Server
+	cfgWatcher *config.Watcher
 
 	// Managers
 	timeMgr *managers.TimeManager
@@ -30,10 +41,15 @@ type App struct {
 	convMgr    *managers.ConversationManager
 
 	// UI state
-	state *ui.UIState
+	state   *ui.UIState
+	model   *ui.Model
+	session *ui.AppSession
 
 	// Coordination
-	stop chan bool
+	stop         chan bool
+	cancel       context.CancelFunc
+	shutdown     *shutdown.WaitGroup
+	shutdownOnce sync.Once
 }
 
 // NewApp creates a new App instance
@@ -44,63 +60,146 @@ func NewApp(cfg *config.Config) *App {
 		cfg.Port,
 		cfg.ReconnectDelay,
 		cfg.MaxReconnectDelay,
+		cfg.MaxReconnectAttempts,
+		[]client.TransportKind{client.WebSocket, client.SSE},
 	)
 
+	// Create renderer with the configured mode and theme
+	mode := ui.ParseLayoutMode(cfg.UIMode)
+	adaptivePercent := ui.ParseAdaptiveHeightPercent(cfg.UIMode)
+	theme, err := ui.LoadTheme(cfg.Theme)
+	if err != nil {
+		logging.Get("ui").Warn("failed to load theme", "error", err)
+	}
+	renderer := ui.NewRendererWithMode(mode, adaptivePercent, theme)
+
+	profile, err := ui.LoadLayoutProfile(cfg.LayoutProfile)
+	if err != nil {
+		logging.Get("ui").Warn("failed to load layout profile", "error", err)
+	}
+	renderer.SetLayoutProfile(profile)
+
 	// Create managers
 	timeMgr := managers.NewTimeManager(cfg.TimeRefreshInterval)
-	weatherMgr := managers.NewWeatherManager(wsClient, cfg.WeatherRefreshInterval)
-	newsMgr := managers.NewNewsManager(wsClient, cfg.NewsRefreshInterval)
-	convMgr := managers.NewConversationManager(cfg.MessageTimeout)
+	weatherMgr := managers.NewWeatherManager(wsClient, cfg.WeatherRefreshInterval, managers.ProviderForFormat(cfg.WeatherFormat), cfg.CacheMaxAge)
+	newsMgr := managers.NewNewsManager(wsClient, cfg.NewsRefreshInterval, cfg.CacheMaxAge)
+	convMgr := managers.NewConversationManager(cfg.MessageTimeout, ui.PickAnimationForCapabilities(renderer.GetCapabilities()))
 
-	// Create renderer with the configured mode
-	mode := ui.ParseLayoutMode(cfg.UIMode)
-	renderer := ui.NewRendererWithMode(mode)
+	if !cfg.AutoFetchWeather {
+		weatherMgr.Pause()
+	}
+	if !cfg.AutoFetchNews {
+		newsMgr.Pause()
+	}
 
 	// Create input handler
 	inputHandler := input.New()
+	if err := inputHandler.SetKeymap(cfg.Keymap); err != nil {
+		logging.Get("input").Warn("failed to set keymap", "error", err)
+	}
+
+	// Create IPC control server
+	ipcServer := ipc.NewServer()
+
+	// Create config file watcher for hot-reload
+	cfgWatcher := config.NewWatcher(cfg)
+
+	session := restoreSession(cfg)
+
+	state := &ui.UIState{
+		ConnState:  client.StateDisconnected,
+		LayoutMode: mode,
+		Session:    session,
+	}
 
 	return &App{
 		config:     cfg,
 		client:     wsClient,
 		renderer:   renderer,
 		input:      inputHandler,
+		ipc:        ipcServer,
+		cfgWatcher: cfgWatcher,
 		timeMgr:    timeMgr,
 		weatherMgr: weatherMgr,
 		newsMgr:    newsMgr,
 		convMgr:    convMgr,
-		state: &ui.UIState{
-			ConnState:  client.StateDisconnected,
-			LayoutMode: mode,
-		},
-		stop: make(chan bool),
+		session:    session,
+		state:      state,
+		model:      ui.NewModel(renderer, state),
+		stop:       make(chan bool),
+		shutdown:   shutdown.NewWaitGroup(),
 	}
 }
 
+// restoreSession rebuilds the tabs persisted in the config file, or starts a
+// fresh single-tab session if none were saved.
+func restoreSession(cfg *config.Config) *ui.AppSession {
+	saved, active := cfg.SavedTabs()
+	if len(saved) == 0 {
+		return ui.NewAppSession()
+	}
+
+	session := ui.NewAppSession()
+	tab := session.ActiveTab()
+	tab.Title = saved[0].Title
+	tab.WeatherLocale = saved[0].WeatherLocale
+	tab.NewsLocale = saved[0].NewsLocale
+
+	for _, t := range saved[1:] {
+		tab := session.NewTab()
+		tab.Title = t.Title
+		tab.WeatherLocale = t.WeatherLocale
+		tab.NewsLocale = t.NewsLocale
+	}
+
+	session.Jump(active + 1)
+	return session
+}
+
 // Start starts the application
 func (a *App) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+
 	// Clear screen and initial render
 	a.renderer.Clear()
-	a.renderer.Render(a.state)
+	a.render()
 
 	// Start managers (before connecting)
-	a.timeMgr.Start()
-	a.weatherMgr.Start()
-	a.newsMgr.Start()
+	a.timeMgr.Start(ctx, a.shutdown)
+	a.weatherMgr.Start(ctx, a.shutdown)
+	a.newsMgr.Start(ctx, a.shutdown)
+	a.convMgr.Start(ctx, a.shutdown)
+	a.client.Start(ctx, a.shutdown)
 
 	// Start input handler
-	err := a.input.Start()
+	err := a.input.Start(ctx, a.shutdown)
 	if err != nil {
 		return fmt.Errorf("failed to start input handler: %w", err)
 	}
 
+	// Start the IPC control socket so scripts and window-manager keybinds
+	// can drive PACE without attaching to this terminal. Non-fatal: PACE
+	// still works fully from the keyboard if this fails (e.g. permissions).
+	if err := a.ipc.Start(ctx, a.shutdown); err != nil {
+		logging.Get("ipc").Warn("failed to start IPC control socket", "error", err)
+	}
+
+	// Start watching config.yaml for edits so they take effect without a
+	// restart. Non-fatal: PACE runs fine on its startup config if this fails
+	// (e.g. the config directory isn't watchable on this platform).
+	if err := a.cfgWatcher.Start(ctx, a.shutdown); err != nil {
+		logging.Get("config").Warn("failed to start config watcher", "error", err)
+	}
+
 	// Try to connect to server (non-blocking)
-	log.Println("Connecting to server...")
+	logging.Get("client").Info("connecting to server...")
 	err = a.client.Connect()
 	if err != nil {
 		// Don't fail - just log and continue
 		// The client will auto-reconnect in the background
-		log.Printf("Initial connection failed: %v", err)
-		log.Println("Will retry in background...")
+		logging.Get("client").Warn("initial connection failed", "error", err)
+		logging.Get("client").Info("will retry in background...")
 
 		// Trigger reconnection in background
 		go a.client.Reconnect()
@@ -120,37 +219,58 @@ func (a *App) Start() error {
 	return nil
 }
 
+// render does a full repaint, then hands the input line back to the editor.
+// A full Render clears and redraws the whole screen, including the row the
+// line editor's prompt lives on - without the follow-up RefreshLine, a
+// background weather/news/time update would wipe out whatever the user had
+// typed so far.
+func (a *App) render() {
+	a.renderer.Paint(a.model.View())
+	a.input.RefreshLine()
+}
+
+// dispatch runs msg through the model's Update and, if it returns a Cmd,
+// runs that too, feeding the Msg it produces back in - eventLoop's one
+// entry point into ui.Model instead of mutating a.state's fields directly.
+func (a *App) dispatch(msg ui.Msg) {
+	_, cmd := a.model.Update(msg)
+	if cmd != nil {
+		a.dispatch(cmd())
+	}
+}
+
 // eventLoop is the main event coordination loop
 func (a *App) eventLoop() {
 	for {
 		select {
 		case <-a.client.Connected():
 			// Client connected - fetch initial data
-			log.Println("Connected to server, fetching initial data...")
+			logging.Get("client").Info("connected to server, fetching initial data...")
 			a.weatherMgr.Fetch()
 			a.newsMgr.Fetch()
-			a.state.ConnState = a.client.State()
-			a.renderer.Render(a.state)
+			a.dispatch(ui.ConnStateMsg{State: a.client.State()})
+			a.render()
 
 		case msg := <-a.client.Messages():
 			a.handleMessage(msg)
 
 		case timeData := <-a.timeMgr.Updates():
-			a.state.Time = timeData
+			a.dispatch(ui.TimeMsg{Data: timeData})
 			// Only update header to avoid flickering
 			a.renderer.RenderHeaderOnly(a.state)
 
 		case weatherData := <-a.weatherMgr.Updates():
-			a.state.Weather = weatherData
-			a.renderer.Render(a.state)
+			a.dispatch(ui.WeatherMsg{Data: weatherData})
+			a.render()
 
 		case newsData := <-a.newsMgr.Updates():
-			a.state.News = newsData
-			a.renderer.Render(a.state)
+			a.dispatch(ui.NewsMsg{Data: newsData})
+			a.render()
 
 		case convData := <-a.convMgr.Updates():
-			a.state.Conversation = convData
-			a.renderer.Render(a.state)
+			a.dispatch(ui.ConversationMsg{Data: convData})
+			a.session.ActiveTab().Conversation = convData
+			a.render()
 
 		case cmd := <-a.input.Commands():
 			a.handleCommand(cmd)
@@ -158,42 +278,44 @@ func (a *App) eventLoop() {
 		case msg := <-a.input.Messages():
 			a.handleUserMessage(msg)
 
+		case n := <-a.input.TabJumps():
+			if a.session.Jump(n) {
+				a.onTabSwitched()
+			}
+
+		case ev := <-a.input.MouseEvents():
+			a.handleMouseEvent(ev)
+
+		case env := <-a.ipc.Requests():
+			a.handleIPCRequest(env)
+
+		case delta := <-a.config.Changes():
+			a.applyConfigDelta(delta)
+
 		case err := <-a.client.Errors():
-			log.Printf("Client error: %v", err)
+			logging.Get("client").Warn("client error", "error", err)
 
 		case <-a.stop:
 			return
 		}
 
 		// Update connection state
-		a.state.ConnState = a.client.State()
+		a.dispatch(ui.ConnStateMsg{State: a.client.State(), Reconnect: a.client.ReconnectStatus()})
 	}
 }
 
-// handleMessage handles incoming WebSocket messages
+// handleMessage handles incoming WebSocket messages. Weather and news
+// responses no longer need pattern-sniffing here - weatherMgr/newsMgr
+// subscribe to their topics directly (see internal/client's topicRouter) -
+// this just keeps the two known auto-fetch queries out of the conversation
+// panel.
 func (a *App) handleMessage(msg *protocol.Message) {
-	queryLower := strings.ToLower(msg.Query)
-
-	// Check if this is an automatic fetch query (don't show in conversation)
 	isAutoFetch := msg.Query == "What's the weather?" || msg.Query == "What's the news?"
-
-	// Update specialized panels if applicable
-	if strings.Contains(queryLower, "weather") {
-		a.weatherMgr.ProcessResponse(msg.Response)
-		// Only show in conversation if user asked (not auto-fetch)
-		if !isAutoFetch {
-			a.convMgr.SetResponse(msg.Response)
-		}
-	} else if strings.Contains(queryLower, "news") {
-		a.newsMgr.ProcessResponse(msg.Response)
-		// Only show in conversation if user asked (not auto-fetch)
-		if !isAutoFetch {
-			a.convMgr.SetResponse(msg.Response)
-		}
-	} else {
-		// Regular conversation - always update
-		a.convMgr.SetResponse(msg.Response)
+	if isAutoFetch {
+		return
 	}
+
+	a.convMgr.SetResponse(msg.Response)
 }
 
 // handleCommand handles user commands
@@ -230,10 +352,226 @@ func (a *App) handleCommand(cmd input.Command) {
 		} else {
 			a.switchLayoutMode(ui.LayoutModeDashboard)
 		}
+
+	case input.CmdTabNew:
+		a.session.NewTab()
+		a.onTabSwitched()
+
+	case input.CmdTabClose:
+		a.session.CloseTab()
+		a.onTabSwitched()
+
+	case input.CmdTabNext:
+		a.session.Next()
+		a.onTabSwitched()
+
+	case input.CmdTabPrev:
+		a.session.Prev()
+		a.onTabSwitched()
+
+	case input.CmdSpinnerChange:
+		select {
+		case choice := <-a.input.SpinnerChoice():
+			a.setSpinner(choice)
+		default:
+		}
+
+	case input.CmdPanelGrow:
+		a.dispatch(ui.PanelGrowMsg{})
+		a.render()
+
+	case input.CmdPanelShrink:
+		a.dispatch(ui.PanelShrinkMsg{})
+		a.render()
+
+	case input.CmdFlipOrientation:
+		a.dispatch(ui.FlipOrientationMsg{})
+		a.render()
+
+	case input.CmdModeFocus:
+		select {
+		case name := <-a.input.FocusChoice():
+			a.dispatch(ui.FocusProfileMsg{Name: name})
+			a.state.LayoutMode = ui.LayoutModeDashboard
+			a.render()
+		default:
+		}
+
+	case input.CmdKeymapChange:
+		select {
+		case name := <-a.input.KeymapChoice():
+			if err := a.input.SetKeymap(name); err != nil {
+				fmt.Printf("\r\n%s\r\n", err)
+			}
+		default:
+		}
+
+	case input.CmdComposeUpdate:
+		select {
+		case text := <-a.input.ComposeUpdate():
+			a.dispatch(ui.ComposeMsg{Text: text})
+			a.render()
+		default:
+		}
+	}
+}
+
+// setSpinner swaps ConversationManager's loading animation in response to a
+// "/spinner <name>" command. An unrecognized name is reported back instead
+// of silently falling through to whatever animation was already active.
+func (a *App) setSpinner(name string) {
+	var anim managers.LoadingAnimation
+	switch name {
+	case "braille":
+		anim = managers.NewBrailleSpinner()
+	case "ascii":
+		anim = managers.AsciiSpinner{}
+	case "dots":
+		anim = managers.DotsAnimation{}
+	default:
+		fmt.Printf("\r\nUnknown spinner: %s\r\n", name)
+		return
+	}
+	a.convMgr.SetAnimation(anim)
+}
+
+// handleMouseEvent routes a parsed mouse report to whichever panel it
+// landed in: clicking the weather or news panel moves focus there (so its
+// border highlights), scrolling inside the conversation panel adjusts its
+// ScrollOffset, and clicking a news headline jumps straight to it.
+func (a *App) handleMouseEvent(ev input.MouseEvent) {
+	layout := a.renderer.GetLayout()
+	panel := layout.PanelAt(ev.X, ev.Y)
+
+	switch ev.Button {
+	case input.MouseWheelUp, input.MouseWheelDown:
+		if panel != ui.PanelConversation {
+			return
+		}
+		delta := 1
+		if ev.Button == input.MouseWheelDown {
+			delta = -1
+		}
+		maxOffset := len(strings.Split(a.state.Conversation.FullResponse, "\n"))
+		a.convMgr.ScrollBy(delta, maxOffset)
+
+	case input.MouseLeft:
+		if panel == ui.PanelNone {
+			return
+		}
+		a.state.FocusedPanel = panel
+		if panel == ui.PanelNews {
+			if i := ui.NewsHeadlineAt(layout, ev.Y); i >= 0 {
+				// Clicking a headline could also open its source URL via an
+				// OSC 8 hyperlink, but NewsData.Headlines are plain strings
+				// parsed out of chat responses with no URL attached, so
+				// there's nothing real to open here.
+				a.newsMgr.SetCurrentIndex(i)
+			}
+		}
+		a.render()
+	}
+}
+
+// handleIPCRequest dispatches a command received over the IPC control
+// socket through the same handleCommand/handleUserMessage paths as input
+// typed into the terminal, then replies on env.Reply.
+func (a *App) handleIPCRequest(env *ipc.Envelope) {
+	req := env.Request
+	resp := ipc.Response{OK: true}
+
+	switch req.Command {
+	case "refresh":
+		if len(req.Args) == 0 {
+			a.weatherMgr.Fetch()
+			a.newsMgr.Fetch()
+			break
+		}
+		switch req.Args[0] {
+		case "weather":
+			a.weatherMgr.Fetch()
+		case "news":
+			a.newsMgr.Fetch()
+		default:
+			resp = ipc.Response{Error: fmt.Sprintf("unknown refresh target: %q", req.Args[0])}
+		}
+
+	case "mode":
+		if len(req.Args) == 0 {
+			resp = ipc.Response{Error: "mode requires an argument: dashboard, minimal, or toggle"}
+			break
+		}
+		switch req.Args[0] {
+		case "dashboard":
+			a.handleCommand(input.CmdModeDashboard)
+		case "minimal":
+			a.handleCommand(input.CmdModeMinimal)
+		case "toggle":
+			a.handleCommand(input.CmdModeToggle)
+		default:
+			resp = ipc.Response{Error: fmt.Sprintf("unknown mode: %q", req.Args[0])}
+		}
+
+	case "send":
+		if len(req.Args) == 0 {
+			resp = ipc.Response{Error: "send requires a message"}
+			break
+		}
+		a.handleUserMessage(strings.Join(req.Args, " "))
+
+	case "status":
+		resp.Status = &ipc.StatusData{
+			ConnState: a.client.State(),
+			Reconnect: a.client.ReconnectStatus(),
+			Weather:   a.weatherMgr.GetData(),
+			News:      a.newsMgr.GetData(),
+			Time:      a.timeMgr.GetData(),
+		}
+
+	case "quit":
+		// Reply before shutting down, since Shutdown ends in os.Exit and
+		// would otherwise race the client reading this response.
+		env.Reply <- resp
+		a.Shutdown()
+		return
+
+	default:
+		resp = ipc.Response{Error: fmt.Sprintf("unknown command: %q", req.Command)}
+	}
+
+	env.Reply <- resp
+}
+
+// onTabSwitched re-renders with the newly active tab's conversation and
+// persists the tab list, since the active tab index just changed.
+func (a *App) onTabSwitched() {
+	a.state.Conversation = a.session.ActiveTab().Conversation
+	a.render()
+	a.persistTabs()
+}
+
+// persistTabs saves the current tab list and active index to config, the
+// same file used by switchLayoutMode to save the UI mode.
+func (a *App) persistTabs() {
+	tabs := make([]config.TabConfig, len(a.session.Tabs))
+	for i, t := range a.session.Tabs {
+		tabs[i] = config.TabConfig{
+			Title:         t.Title,
+			WeatherLocale: t.WeatherLocale,
+			NewsLocale:    t.NewsLocale,
+		}
+	}
+
+	if err := a.config.SaveTabs(tabs, a.session.Active); err != nil {
+		logging.Get("ui").Warn("failed to save tabs", "error", err)
 	}
 }
 
-// handleUserMessage handles user messages
+// handleUserMessage handles user messages. Unlike NewsManager.Fetch, it
+// doesn't attempt client.Request first: a freeform user query has no typed
+// payload to request, and the real PACE server answers it asynchronously
+// over Messages() rather than with a correlated Envelope reply, so a
+// blocking Request here would just time out on every real query.
 func (a *App) handleUserMessage(message string) {
 	if !a.client.IsConnected() {
 		a.convMgr.SetQuery("Not connected")
@@ -253,11 +591,7 @@ func (a *App) handleUserMessage(message string) {
 
 // switchLayoutMode switches to a different layout mode
 func (a *App) switchLayoutMode(mode ui.LayoutMode) {
-	// Update state
-	a.state.LayoutMode = mode
-
-	// Update renderer
-	a.renderer.SetLayoutMode(mode)
+	a.dispatch(ui.ModeMsg{Mode: mode})
 
 	// Pause/resume managers based on mode
 	if mode == ui.LayoutModeMinimal {
@@ -271,15 +605,53 @@ func (a *App) switchLayoutMode(mode ui.LayoutMode) {
 	}
 
 	// Re-render with new layout
-	a.renderer.Render(a.state)
+	a.render()
 
 	// Save mode to config
 	err := a.config.SaveUIMode(mode.String())
 	if err != nil {
-		log.Printf("Warning: failed to save UI mode: %v", err)
+		logging.Get("ui").Warn("failed to save UI mode", "error", err)
 	}
 }
 
+// applyConfigDelta applies a hot-reloaded config.yaml change to the running
+// app. Only the fields config.Watcher actually found changed are non-nil.
+func (a *App) applyConfigDelta(delta config.ConfigDelta) {
+	if delta.WeatherRefreshInterval != nil {
+		a.config.WeatherRefreshInterval = *delta.WeatherRefreshInterval
+		a.weatherMgr.SetInterval(*delta.WeatherRefreshInterval)
+	}
+
+	if delta.NewsRefreshInterval != nil {
+		a.config.NewsRefreshInterval = *delta.NewsRefreshInterval
+		a.newsMgr.SetInterval(*delta.NewsRefreshInterval)
+	}
+
+	if delta.AutoFetchWeather != nil {
+		a.config.AutoFetchWeather = *delta.AutoFetchWeather
+		if *delta.AutoFetchWeather {
+			a.weatherMgr.Resume()
+		} else {
+			a.weatherMgr.Pause()
+		}
+	}
+
+	if delta.AutoFetchNews != nil {
+		a.config.AutoFetchNews = *delta.AutoFetchNews
+		if *delta.AutoFetchNews {
+			a.newsMgr.Resume()
+		} else {
+			a.newsMgr.Pause()
+		}
+	}
+
+	if delta.UIMode != nil {
+		a.switchLayoutMode(ui.ParseLayoutMode(*delta.UIMode))
+	}
+
+	a.render()
+}
+
 // showHelp displays help information
 func (a *App) showHelp() {
 	helpText := `Available commands:
@@ -290,13 +662,23 @@ func (a *App) showHelp() {
 /dashboard, /dash - Switch to dashboard mode
 /minimal, /min - Switch to minimal mode
 /toggle - Toggle between modes
+/tab new, /tab close, /tab next, /tab prev - Manage tabs
 
 Keyboard Shortcuts:
 Ctrl+D - Dashboard mode
 Ctrl+T - Toggle between modes
+Ctrl+N - New tab
+Ctrl+W - Close tab
+Ctrl+Tab / Ctrl+Shift+Tab - Next/previous tab
+Alt+1..9 - Jump to tab
 → - Next news headline
 ← - Previous news headline
 
+Mouse:
+Click weather/news panel - Focus it (highlights border)
+Click a headline - Jump to it
+Scroll wheel over conversation - Scroll through history
+
 Just type your message and press Enter to chat with PACE!`
 
 	a.convMgr.SetQuery("/help")
@@ -305,27 +687,51 @@ Just type your message and press Enter to chat with PACE!`
 
 // Shutdown gracefully shuts down the application
 func (a *App) Shutdown() {
-	log.Println("Shutting down...")
+	a.shutdownOnce.Do(func() {
+		logging.Get("app").Info("shutting down...")
+
+		// Persist the active layout profile, if it was changed in dashboard mode
+		if profile, ok := a.renderer.ActiveProfile(); ok {
+			if err := a.config.SaveLayoutProfile(profile.Name); err != nil {
+				logging.Get("ui").Warn("failed to save layout profile", "error", err)
+			}
+		}
 
-	// Stop event loop
-	close(a.stop)
+		// Stop event loop
+		close(a.stop)
 
-	// Stop managers
-	a.timeMgr.Stop()
-	a.weatherMgr.Stop()
-	a.newsMgr.Stop()
+		// Stop managers
+		a.timeMgr.Stop()
+		a.weatherMgr.Stop()
+		a.newsMgr.Stop()
+		a.convMgr.Stop()
 
-	// Close client
-	a.client.Close()
+		// Close client
+		a.client.Close()
 
-	// Close input handler
-	a.input.Close()
+		// Close input handler
+		a.input.Close()
 
-	// Clear screen and show goodbye message
-	a.renderer.Clear()
-	fmt.Println("Thanks for using PACE Terminal! Goodbye.")
+		// Close the IPC control socket
+		if err := a.ipc.Close(); err != nil {
+			logging.Get("ipc").Warn("failed to close IPC control socket", "error", err)
+		}
+
+		// Cancel the root context, then wait (bounded) for every registered
+		// goroutine - fetches, the reconnect loop, the read pump - to
+		// actually finish before exiting, so we don't drop the final
+		// message send or leave a socket half-open.
+		if a.cancel != nil {
+			a.cancel()
+		}
+		a.shutdown.Wait(shutdownTimeout)
+
+		// Clear screen and show goodbye message
+		a.renderer.Clear()
+		fmt.Println("Thanks for using PACE Terminal! Goodbye.")
 
-	os.Exit(0)
+		os.Exit(0)
+	})
 }
 
 func main() {
@@ -336,6 +742,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Route log.* calls across the app to the configured sink instead of
+	// the terminal, so a file sink doesn't collide with the TUI's own
+	// rendering.
+	if err := logging.Init(logging.Options{
+		Level:      cfg.LogLevel,
+		Sink:       cfg.LogSink,
+		File:       cfg.LogFile,
+		MaxSizeMB:  cfg.LogMaxSizeMB,
+		MaxAgeDays: cfg.LogMaxAgeDays,
+		MaxBackups: cfg.LogMaxBackups,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to initialize logging: %v\n", err)
+	}
+
 	// Check if we need to show the startup menu
 	if cfg.UIMode == "" && !cfg.HasModeFlagOverride() {
 		selectedMode := ui.ShowLayoutSelectionMenu()
@@ -0,0 +1,36 @@
+package protocol
+
+import "encoding/json"
+
+// Envelope is a structured, JSON-encoded alternative to the delimited
+// Message wire format, for exchanges that need to correlate a specific
+// reply to a specific request rather than just reacting to whatever
+// arrives next. InReplyTo is empty on a request and set to the requesting
+// Envelope's ID on its reply.
+type Envelope struct {
+	ID        string          `json:"id"`
+	InReplyTo string          `json:"in_reply_to,omitempty"`
+	Type      string          `json:"type"`
+	Topic     string          `json:"topic,omitempty"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// ParseEnvelope parses a raw WebSocket message as a JSON-encoded Envelope.
+// It returns an error for anything that isn't a JSON object, including the
+// delimited Message format Parse handles.
+func ParseEnvelope(raw string) (*Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal([]byte(raw), &env); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// String returns e in wire format.
+func (e *Envelope) String() string {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -6,30 +6,47 @@ import (
 )
 
 var (
-	// ErrInvalidFormat is returned when message doesn't contain exactly one $$ delimiter
-	ErrInvalidFormat = errors.New("invalid message format: must contain exactly one '$$' delimiter")
+	// ErrInvalidFormat is returned when message doesn't split into exactly
+	// two parts ("query$$response") or three ("topic$$query$$response").
+	ErrInvalidFormat = errors.New("invalid message format: must contain exactly one or two '$$' delimiters")
 )
 
-// Message represents a parsed WebSocket message
+// Message represents a parsed WebSocket message. Topic is empty for the
+// original "query$$response" wire format; a server that wants a message
+// routed by topic (see internal/client's subscription router) sends
+// "topic$$query$$response" instead.
 type Message struct {
+	Topic    string
 	Query    string
 	Response string
 }
 
-// Parse parses a raw WebSocket message in format "query$$response"
+// Parse parses a raw WebSocket message in "query$$response" format, or the
+// topic-tagged "topic$$query$$response" format.
 func Parse(raw string) (*Message, error) {
 	parts := strings.Split(raw, "$$")
-	if len(parts) != 2 {
+
+	switch len(parts) {
+	case 2:
+		return &Message{
+			Query:    strings.TrimSpace(parts[0]),
+			Response: strings.TrimSpace(parts[1]),
+		}, nil
+	case 3:
+		return &Message{
+			Topic:    strings.TrimSpace(parts[0]),
+			Query:    strings.TrimSpace(parts[1]),
+			Response: strings.TrimSpace(parts[2]),
+		}, nil
+	default:
 		return nil, ErrInvalidFormat
 	}
-
-	return &Message{
-		Query:    strings.TrimSpace(parts[0]),
-		Response: strings.TrimSpace(parts[1]),
-	}, nil
 }
 
-// String returns the message in wire format
+// String returns the message in wire format.
 func (m *Message) String() string {
+	if m.Topic != "" {
+		return m.Topic + "$$" + m.Query + "$$" + m.Response
+	}
 	return m.Query + "$$" + m.Response
 }